@@ -1,11 +1,29 @@
 // Package constraints provides type constraints used by the iterator package.
 package constraints
 
+// Signed is a type constraint that matches all signed integer types.
+type Signed interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Unsigned is a type constraint that matches all unsigned integer types.
+type Unsigned interface {
+	~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Integer is a type constraint that matches all integer types.
+type Integer interface {
+	Signed | Unsigned
+}
+
+// Float is a type constraint that matches all floating point types.
+type Float interface {
+	~float32 | ~float64
+}
+
 // RealNumber is a type constraint that matches all real numeric types.
 type RealNumber interface {
-	~int | ~int8 | ~int16 | ~int32 | ~int64 |
-		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr |
-		~float32 | ~float64
+	Integer | Float
 }
 
 // Number is a type constraint that matches all numeric types.
@@ -0,0 +1,42 @@
+package iterator
+
+import (
+	"iter"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// First returns the first value of seq, or an empty [optional.Optional] if it
+// yields no values. Only the first value is consumed.
+func First[T any](seq iter.Seq[T]) optional.Optional[T] {
+	for v := range seq {
+		return optional.Of(v)
+	}
+
+	return optional.Empty[T]()
+}
+
+// Last returns the last value of seq, or an empty [optional.Optional] if it
+// yields no values. The whole sequence is consumed.
+func Last[T any](seq iter.Seq[T]) optional.Optional[T] {
+	last := optional.Empty[T]()
+
+	for v := range seq {
+		last = optional.Of(v)
+	}
+
+	return last
+}
+
+// Find returns the first value of seq for which condition returns true, or an
+// empty [optional.Optional] if there is none. Values after the found one are
+// not consumed.
+func Find[T any](seq iter.Seq[T], condition func(T) bool) optional.Optional[T] {
+	for v := range seq {
+		if condition(v) {
+			return optional.Of(v)
+		}
+	}
+
+	return optional.Empty[T]()
+}
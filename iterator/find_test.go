@@ -0,0 +1,80 @@
+package iterator_test
+
+import (
+	"fmt"
+	"iter"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleFind() {
+	s := iterator.Of(1, 3, 4, 5, 6)
+
+	fmt.Println(iterator.Find(s, isEven))
+	fmt.Println(iterator.First(s), iterator.Last(s))
+	fmt.Println(iterator.Find(s, func(i int) bool { return i > 10 }))
+
+	// Output:
+	// (Optional[int]: 4)
+	// (Optional[int]: 1) (Optional[int]: 6)
+	// (Optional[int] <empty>)
+}
+
+// countingSeq yields the given values and counts how many were consumed.
+func countingSeq(consumed *int, values ...int) iter.Seq[int] {
+	return func(yield func(int) bool) {
+		for _, v := range values {
+			*consumed++
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+func TestFirst_Last_Find(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		name           string
+		seq            func(consumed *int) optional.Optional[int]
+		want           optional.Optional[int]
+		expectConsumed int
+	}
+
+	testCases := []testCase{
+		{"first", func(c *int) optional.Optional[int] { return iterator.First(countingSeq(c, 1, 2, 3)) }, optional.Of(1), 1},
+		{"first empty", func(c *int) optional.Optional[int] { return iterator.First(countingSeq(c)) }, optional.Empty[int](), 0},
+		{"last", func(c *int) optional.Optional[int] { return iterator.Last(countingSeq(c, 1, 2, 3)) }, optional.Of(3), 3},
+		{"last empty", func(c *int) optional.Optional[int] { return iterator.Last(countingSeq(c)) }, optional.Empty[int](), 0},
+		{
+			"find", func(c *int) optional.Optional[int] { return iterator.Find(countingSeq(c, 1, 2, 3, 4), isEven) },
+			optional.Of(2), 2,
+		},
+		{
+			"find none", func(c *int) optional.Optional[int] { return iterator.Find(countingSeq(c, 1, 3, 5), isEven) },
+			optional.Empty[int](), 3,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			consumed := 0
+
+			// Act
+			got := tc.seq(&consumed)
+
+			// Assert
+			assert.Equal(t, tc.want, got)
+			assert.Equal(t, tc.expectConsumed, consumed)
+		})
+	}
+}
@@ -14,6 +14,10 @@ func Count[T any](acc *int, _ T) {
 }
 
 // Sum adds the given value to acc.
+//
+// Integers silently wrap around on overflow and floats lose precision on long
+// streams, see [CheckedSum], [SaturatingSum], [KahanSum] and [NeumaierSum] for
+// safer alternatives.
 func Sum[T constraints.RealNumber](acc *T, in T) {
 	*acc += in
 }
@@ -0,0 +1,223 @@
+package statistics
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+// Bivariate is a [iterator.Reducer], which collects covariance, Pearson
+// correlation and linear regression of a stream of paired values.
+//
+// The moments are updated online with Welford's algorithm, which is
+// numerically stable also for large values and long streams.
+//
+// To reduce an [iter.Seq2], it can be converted with [iterator.Combine].
+func Bivariate[X, Y constraints.RealNumber](acc *BivariateAccumulator, in tuple.Pair[X, Y]) {
+	x, y := float64(in.First()), float64(in.Second())
+
+	acc.count++
+	n := float64(acc.count)
+
+	dx := x - acc.meanX
+	dy := y - acc.meanY
+
+	acc.meanX += dx / n
+	acc.meanY += dy / n
+
+	acc.m2X += dx * (x - acc.meanX)
+	acc.m2Y += dy * (y - acc.meanY)
+	acc.coMoment += dx * (y - acc.meanY)
+}
+
+// BivariateAccumulator is the accumulator type for the [Bivariate] reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type BivariateAccumulator struct {
+	count int
+
+	meanX float64
+	meanY float64
+
+	// m2X and m2Y are the sums of squared differences from the means,
+	// coMoment the sum of the products of the differences of x and y.
+	m2X      float64
+	m2Y      float64
+	coMoment float64
+}
+
+// LinearRegression is the result of an ordinary least squares regression
+// y = Slope·x + Intercept.
+type LinearRegression struct {
+	Slope     float64
+	Intercept float64
+	// RSquared is the coefficient of determination, the proportion of the
+	// variance of y explained by the regression.
+	RSquared float64
+}
+
+// Predict returns the value predicted by the regression for x.
+func (l LinearRegression) Predict(x float64) float64 {
+	return l.Slope*x + l.Intercept
+}
+
+// Count returns the number of gathered pairs.
+func (b BivariateAccumulator) Count() int {
+	return b.count
+}
+
+// Covariance returns the sample covariance of the gathered pairs, or NaN if
+// less than two pairs were gathered.
+func (b BivariateAccumulator) Covariance() float64 {
+	if b.count < 2 { //nolint:mnd
+		return math.NaN()
+	}
+
+	return b.coMoment / float64(b.count-1)
+}
+
+// PopulationCovariance returns the population covariance of the gathered
+// pairs, or NaN if no pairs were gathered.
+func (b BivariateAccumulator) PopulationCovariance() float64 {
+	return b.coMoment / float64(b.count)
+}
+
+// Correlation returns the Pearson correlation coefficient of the gathered
+// pairs, or NaN if less than two pairs were gathered or one of the variables
+// is constant.
+func (b BivariateAccumulator) Correlation() float64 {
+	if b.count < 2 || b.m2X == 0 || b.m2Y == 0 { //nolint:mnd
+		return math.NaN()
+	}
+
+	return b.coMoment / math.Sqrt(b.m2X*b.m2Y)
+}
+
+// Regression returns the ordinary least squares regression of y on x.
+//
+// All values are NaN, if less than two pairs were gathered or all x are equal.
+func (b BivariateAccumulator) Regression() LinearRegression {
+	if b.count < 2 || b.m2X == 0 { //nolint:mnd
+		return LinearRegression{Slope: math.NaN(), Intercept: math.NaN(), RSquared: math.NaN()}
+	}
+
+	slope := b.coMoment / b.m2X
+
+	rSquared := 1.0
+	if b.m2Y != 0 {
+		rSquared = b.coMoment * b.coMoment / (b.m2X * b.m2Y)
+	}
+
+	return LinearRegression{
+		Slope:     slope,
+		Intercept: b.meanY - slope*b.meanX,
+		RSquared:  rSquared,
+	}
+}
+
+// Merge combines the pairs gathered by other into b, as if all pairs had been
+// gathered by b.
+func (b *BivariateAccumulator) Merge(other *BivariateAccumulator) {
+	if other.count == 0 {
+		return
+	}
+
+	if b.count == 0 {
+		*b = *other
+		return
+	}
+
+	nA, nB := float64(b.count), float64(other.count)
+	n := nA + nB
+
+	dx := other.meanX - b.meanX
+	dy := other.meanY - b.meanY
+
+	b.m2X += other.m2X + dx*dx*nA*nB/n
+	b.m2Y += other.m2Y + dy*dy*nA*nB/n
+	b.coMoment += other.coMoment + dx*dy*nA*nB/n
+
+	b.meanX += dx * nB / n
+	b.meanY += dy * nB / n
+	b.count += other.count
+}
+
+// Spearman is a [iterator.Reducer], which collects all pairs of a stream to
+// compute the exact Spearman rank correlation afterward.
+//
+// As all pairs are kept in memory, it is meant for bounded data.
+func Spearman[X, Y cmp.Ordered](acc *SpearmanAccumulator[X, Y], in tuple.Pair[X, Y]) {
+	acc.pairs = append(acc.pairs, in)
+}
+
+// SpearmanAccumulator is the accumulator type for the [Spearman] reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type SpearmanAccumulator[X, Y cmp.Ordered] struct {
+	pairs []tuple.Pair[X, Y]
+}
+
+// Merge adds all pairs gathered by other to s.
+func (s *SpearmanAccumulator[X, Y]) Merge(other *SpearmanAccumulator[X, Y]) {
+	s.pairs = append(s.pairs, other.pairs...)
+}
+
+// Correlation returns the Spearman rank correlation coefficient of the
+// gathered pairs, which is the Pearson correlation of their ranks. Tied values
+// get the average of their ranks.
+//
+// It returns NaN if less than two pairs were gathered or one of the variables
+// is constant.
+func (s SpearmanAccumulator[X, Y]) Correlation() float64 {
+	xs := make([]X, len(s.pairs))
+	ys := make([]Y, len(s.pairs))
+
+	for i, p := range s.pairs {
+		xs[i], ys[i] = p.Unpack()
+	}
+
+	rankX := ranks(xs)
+	rankY := ranks(ys)
+
+	acc := BivariateAccumulator{}
+	for i := range rankX {
+		Bivariate(&acc, tuple.PairOf(rankX[i], rankY[i]))
+	}
+
+	return acc.Correlation()
+}
+
+// ranks returns the rank of each value, starting at 1, tied values get the
+// average of their ranks.
+func ranks[T cmp.Ordered](values []T) []float64 {
+	order := make([]int, len(values))
+	for i := range order {
+		order[i] = i
+	}
+
+	slices.SortFunc(order, func(a, b int) int {
+		return cmp.Compare(values[a], values[b])
+	})
+
+	result := make([]float64, len(values))
+
+	for start := 0; start < len(order); {
+		end := start + 1
+		for end < len(order) && cmp.Compare(values[order[end]], values[order[start]]) == 0 {
+			end++
+		}
+
+		// Average of the 1-based ranks start+1 ... end
+		rank := float64(start+end+1) / 2 //nolint:mnd
+		for _, i := range order[start:end] {
+			result[i] = rank
+		}
+
+		start = end
+	}
+
+	return result
+}
@@ -0,0 +1,222 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+func ExampleBivariate() {
+	hours := iterator.Of(1, 2, 3, 4, 5)
+	score := iterator.Of(52.0, 55.5, 61.0, 64.5, 69.0)
+
+	acc := statistics.BivariateAccumulator{}
+	iterator.Reduce(iterator.Zip(hours, score), &acc, statistics.Bivariate[int, float64])
+
+	regression := acc.Regression()
+
+	fmt.Printf("Covariance: %.2f\n", acc.Covariance())
+	fmt.Printf("Correlation: %.4f\n", acc.Correlation())
+	fmt.Printf("y = %.2fx + %.2f (R² = %.4f)\n", regression.Slope, regression.Intercept, regression.RSquared)
+
+	// Output:
+	// Covariance: 10.75
+	// Correlation: 0.9978
+	// y = 4.30x + 47.50 (R² = 0.9957)
+}
+
+func ExampleSpearman() {
+	// Pairs from an iter.Seq2 can be reduced after combining them.
+	pairs := iterator.Combine(slices.All([]float64{1, 8, 27, 64, 125}))
+
+	acc := statistics.SpearmanAccumulator[int, float64]{}
+	iterator.Reduce(pairs, &acc, statistics.Spearman[int, float64])
+
+	fmt.Println(acc.Correlation())
+
+	// Output: 1
+}
+
+//nolint:funlen
+func TestBivariate_matchesTwoPass(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(3))
+
+	// Large offset to check numerical stability
+	const offset = 1e9
+
+	xs := make([]float64, 10_000)
+	ys := make([]float64, len(xs))
+
+	for i := range xs {
+		xs[i] = offset + rand.NormFloat64()
+		ys[i] = -offset + 3*xs[i] + rand.NormFloat64()
+	}
+
+	meanX, meanY := twoPassMean(xs), twoPassMean(ys)
+
+	var sxx, syy, sxy float64
+
+	for i := range xs {
+		sxx += (xs[i] - meanX) * (xs[i] - meanX)
+		syy += (ys[i] - meanY) * (ys[i] - meanY)
+		sxy += (xs[i] - meanX) * (ys[i] - meanY)
+	}
+
+	n := float64(len(xs))
+
+	// Act
+	acc := statistics.BivariateAccumulator{}
+	iterator.Reduce(iterator.Zip(iterator.Of(xs...), iterator.Of(ys...)), &acc, statistics.Bivariate[float64, float64])
+
+	// Assert
+	regression := acc.Regression()
+
+	assert.Equal(t, len(xs), acc.Count())
+	assert.InEpsilon(t, sxy/(n-1), acc.Covariance(), 1e-6)
+	assert.InEpsilon(t, sxy/n, acc.PopulationCovariance(), 1e-6)
+	assert.InEpsilon(t, sxy/math.Sqrt(sxx*syy), acc.Correlation(), 1e-6)
+	assert.InEpsilon(t, sxy/sxx, regression.Slope, 1e-6)
+	assert.InEpsilon(t, sxy*sxy/(sxx*syy), regression.RSquared, 1e-6)
+	assert.InDelta(t, meanY, regression.Predict(meanX), 1e-2)
+}
+
+func TestBivariate_degenerate(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name  string
+		input []tuple.Pair[int, int]
+	}
+
+	tests := []test{
+		{"empty", nil},
+		{"single pair", []tuple.Pair[int, int]{tuple.PairOf(1, 2)}},
+		{"constant x", []tuple.Pair[int, int]{tuple.PairOf(1, 2), tuple.PairOf(1, 3)}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			acc := statistics.BivariateAccumulator{}
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &acc, statistics.Bivariate[int, int])
+
+			// Assert
+			regression := acc.Regression()
+
+			assert.True(t, math.IsNaN(acc.Correlation()))
+			assert.True(t, math.IsNaN(regression.Slope))
+			assert.True(t, math.IsNaN(regression.Intercept))
+			assert.True(t, math.IsNaN(regression.RSquared))
+		})
+	}
+}
+
+func TestBivariateAccumulator_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	pairs := make([]tuple.Pair[int, int], 0, 100)
+	for i := range 100 {
+		pairs = append(pairs, tuple.PairOf(i, i*i%17))
+	}
+
+	all := statistics.BivariateAccumulator{}
+	iterator.Reduce(iterator.Of(pairs...), &all, statistics.Bivariate[int, int])
+
+	a := statistics.BivariateAccumulator{}
+	b := statistics.BivariateAccumulator{}
+	empty := statistics.BivariateAccumulator{}
+
+	iterator.Reduce(iterator.Of(pairs[:30]...), &a, statistics.Bivariate[int, int])
+	iterator.Reduce(iterator.Of(pairs[30:]...), &b, statistics.Bivariate[int, int])
+
+	// Act
+	a.Merge(&b)
+	a.Merge(&empty)
+	empty.Merge(&a)
+
+	// Assert
+	for _, acc := range []statistics.BivariateAccumulator{a, empty} {
+		assert.Equal(t, all.Count(), acc.Count())
+		assert.InEpsilon(t, all.Covariance(), acc.Covariance(), 1e-9)
+		assert.InEpsilon(t, all.Correlation(), acc.Correlation(), 1e-9)
+		assert.InEpsilon(t, all.Regression().Intercept, acc.Regression().Intercept, 1e-9)
+	}
+}
+
+func TestSpearman(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		xs     []float64
+		ys     []float64
+		expect float64
+	}
+
+	tests := []test{
+		{"monotonic increasing", []float64{1, 2, 3, 4}, []float64{1, 10, 100, 1000}, 1},
+		{"monotonic decreasing", []float64{1, 2, 3, 4}, []float64{4, 1, 0, -7}, -1},
+		// Values as computed by scipy.stats.spearmanr
+		{"with ties", []float64{1, 2, 2, 3, 4}, []float64{2, 1, 4, 3, 5}, 0.6668859288553503},
+		{"mixed", []float64{10, 20, 30, 40, 50}, []float64{3, 1, 4, 1, 5}, 0.41039134083406165},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			acc := statistics.SpearmanAccumulator[float64, float64]{}
+
+			// Act
+			iterator.Reduce(
+				iterator.Zip(iterator.Of(tc.xs...), iterator.Of(tc.ys...)),
+				&acc,
+				statistics.Spearman[float64, float64],
+			)
+
+			// Assert
+			assert.InDelta(t, tc.expect, acc.Correlation(), 1e-9)
+		})
+	}
+}
+
+func TestSpearmanAccumulator_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := statistics.SpearmanAccumulator[string, int]{}
+	b := statistics.SpearmanAccumulator[string, int]{}
+
+	statistics.Spearman(&a, tuple.PairOf("a", 1))
+	statistics.Spearman(&b, tuple.PairOf("b", 2))
+	statistics.Spearman(&b, tuple.PairOf("c", 3))
+
+	// Act
+	a.Merge(&b)
+
+	// Assert
+	assert.InDelta(t, 1.0, a.Correlation(), 1e-9)
+}
+
+func twoPassMean(values []float64) float64 {
+	sum := 0.0
+	for _, v := range values {
+		sum += v
+	}
+
+	return sum / float64(len(values))
+}
@@ -0,0 +1,441 @@
+package statistics
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"iter"
+	"math"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+)
+
+// ErrIncompatibleLayout is returned when merging or decoding histograms whose
+// bucket layouts don't match.
+var ErrIncompatibleLayout = errors.New("incompatible histogram bucket layout")
+
+// maxBarWidth is the number of characters of the longest bar rendered by
+// [HistogramAccumulator.String].
+const maxBarWidth = 40
+
+// Histogram is a [iterator.Reducer], which counts the values of a stream in
+// the buckets of a [HistogramAccumulator]. NaN values are ignored.
+func Histogram[T constraints.RealNumber](acc *HistogramAccumulator, in T) {
+	acc.Add(float64(in))
+}
+
+// BucketLayout defines the boundaries of the buckets of a histogram.
+//
+// Each bucket includes its lower and excludes its upper boundary. Values below
+// the first boundary are counted in an underflow bucket, values at or above
+// the last boundary in an overflow bucket.
+type BucketLayout struct {
+	boundaries []float64
+}
+
+// LinearBuckets creates a [BucketLayout] with count buckets of equal width,
+// with the first one starting at start.
+//
+// Panics if width or count are not positive.
+func LinearBuckets(start, width float64, count int) BucketLayout {
+	if !(width > 0) || count <= 0 {
+		panic("width and count must be positive")
+	}
+
+	boundaries := make([]float64, count+1)
+	for i := range boundaries {
+		boundaries[i] = start + float64(i)*width
+	}
+
+	return BucketLayout{boundaries: boundaries}
+}
+
+// ExponentialBuckets creates a [BucketLayout] with count buckets, where the
+// first one starts at start and each boundary is factor times the previous
+// one.
+//
+// Panics if start is not positive, factor is not greater than 1 or count is
+// not positive.
+func ExponentialBuckets(start, factor float64, count int) BucketLayout {
+	if !(start > 0) || !(factor > 1) || count <= 0 {
+		panic("start and count must be positive and factor greater than 1")
+	}
+
+	boundaries := make([]float64, count+1)
+	for i := range boundaries {
+		boundaries[i] = start * math.Pow(factor, float64(i))
+	}
+
+	return BucketLayout{boundaries: boundaries}
+}
+
+// ExplicitBuckets creates a [BucketLayout] from the given boundaries, n
+// boundaries define n-1 buckets.
+//
+// Panics if less than two boundaries are given or they are not strictly
+// increasing.
+func ExplicitBuckets(boundaries ...float64) BucketLayout {
+	err := validateBoundaries(boundaries)
+	if err != nil {
+		panic(err.Error())
+	}
+
+	return BucketLayout{boundaries: slices.Clone(boundaries)}
+}
+
+// LogLinearBuckets creates a HDR-style [BucketLayout] for values from lowest
+// to highest, as typically used for latencies.
+//
+// Each power of two range [lowest·2ᵏ, lowest·2ᵏ⁺¹) is split into subBuckets
+// buckets of equal width, so the relative error of a value represented by its
+// bucket is at most 1/subBuckets, while the number of buckets only grows
+// logarithmically with highest/lowest.
+//
+// Panics if lowest or subBuckets are not positive or highest is not greater
+// than lowest.
+func LogLinearBuckets(lowest, highest float64, subBuckets int) BucketLayout {
+	if !(lowest > 0) || !(highest > lowest) || subBuckets <= 0 {
+		panic("lowest and subBuckets must be positive and highest greater than lowest")
+	}
+
+	boundaries := []float64{lowest}
+
+	for rangeStart := lowest; boundaries[len(boundaries)-1] < highest; rangeStart *= 2 {
+		width := rangeStart / float64(subBuckets)
+
+		for i := 1; i <= subBuckets; i++ {
+			boundaries = append(boundaries, rangeStart+float64(i)*width)
+		}
+	}
+
+	return BucketLayout{boundaries: boundaries}
+}
+
+// Boundaries returns a copy of the bucket boundaries.
+func (l BucketLayout) Boundaries() []float64 {
+	return slices.Clone(l.boundaries)
+}
+
+// Bucket is a single bucket of a histogram, counting the values in the range
+// [Lower, Upper).
+type Bucket struct {
+	Lower float64
+	Upper float64
+	Count int
+}
+
+// String returns the range of the bucket in interval notation, like "[0, 10)".
+func (b Bucket) String() string {
+	if math.IsInf(b.Lower, -1) {
+		return fmt.Sprintf("(%g, %g)", b.Lower, b.Upper)
+	}
+
+	return fmt.Sprintf("[%g, %g)", b.Lower, b.Upper)
+}
+
+// HistogramAccumulator is the accumulator type for the [Histogram] reducer.
+//
+// It must be created with [NewHistogramAccumulator].
+type HistogramAccumulator struct {
+	layout BucketLayout
+
+	counts    []int
+	underflow int
+	overflow  int
+
+	count int
+	min   float64
+	max   float64
+}
+
+// NewHistogramAccumulator creates an empty [HistogramAccumulator] with the
+// given layout.
+func NewHistogramAccumulator(layout BucketLayout) HistogramAccumulator {
+	if len(layout.boundaries) < 2 { //nolint:mnd
+		panic("layout must be created by one of the layout functions")
+	}
+
+	return HistogramAccumulator{
+		layout: layout,
+		counts: make([]int, len(layout.boundaries)-1),
+	}
+}
+
+// Add counts the given value in its bucket. NaN values are ignored.
+func (h *HistogramAccumulator) Add(value float64) {
+	if math.IsNaN(value) {
+		return
+	}
+
+	if h.count == 0 {
+		h.min, h.max = value, value
+	}
+
+	h.min = min(h.min, value)
+	h.max = max(h.max, value)
+	h.count++
+
+	boundaries := h.layout.boundaries
+
+	switch {
+	case value < boundaries[0]:
+		h.underflow++
+	case value >= boundaries[len(boundaries)-1]:
+		h.overflow++
+	default:
+		h.counts[sort.Search(len(boundaries), func(i int) bool { return boundaries[i] > value })-1]++
+	}
+}
+
+// Count returns the number of values counted by the histogram.
+func (h HistogramAccumulator) Count() int {
+	return h.count
+}
+
+// Underflow returns the number of values below the lowest boundary.
+func (h HistogramAccumulator) Underflow() int {
+	return h.underflow
+}
+
+// Overflow returns the number of values at or above the highest boundary.
+func (h HistogramAccumulator) Overflow() int {
+	return h.overflow
+}
+
+// Layout returns the bucket layout of the histogram.
+func (h HistogramAccumulator) Layout() BucketLayout {
+	return h.layout
+}
+
+// Buckets creates an iterator over all buckets in ascending order, starting
+// with the underflow bucket (-Inf, lowest boundary) and ending with the
+// overflow bucket [highest boundary, +Inf).
+func (h HistogramAccumulator) Buckets() iter.Seq[Bucket] {
+	return func(yield func(Bucket) bool) {
+		boundaries := h.layout.boundaries
+
+		if !yield(Bucket{Lower: math.Inf(-1), Upper: boundaries[0], Count: h.underflow}) {
+			return
+		}
+
+		for i, count := range h.counts {
+			if !yield(Bucket{Lower: boundaries[i], Upper: boundaries[i+1], Count: count}) {
+				return
+			}
+		}
+
+		yield(Bucket{Lower: boundaries[len(boundaries)-1], Upper: math.Inf(1), Count: h.overflow})
+	}
+}
+
+// Cumulative creates an iterator like [HistogramAccumulator.Buckets], but the
+// Count of each yielded bucket is the number of all values below its upper
+// boundary.
+func (h HistogramAccumulator) Cumulative() iter.Seq[Bucket] {
+	return func(yield func(Bucket) bool) {
+		sum := 0
+
+		for b := range h.Buckets() {
+			sum += b.Count
+			b.Count = sum
+
+			if !yield(b) {
+				return
+			}
+		}
+	}
+}
+
+// Quantile estimates the p-quantile from the bucket counts, by interpolating
+// linearly within the bucket containing it. The estimate is clamped to the
+// minimum and maximum value seen.
+//
+// It returns NaN if the histogram is empty and panics if p is not within
+// [0, 1].
+func (h HistogramAccumulator) Quantile(p float64) float64 {
+	checkProbability(p)
+
+	if h.count == 0 {
+		return math.NaN()
+	}
+
+	rank := p * float64(h.count)
+	below := 0
+
+	for b := range h.Buckets() {
+		if b.Count == 0 || float64(below+b.Count) < rank {
+			below += b.Count
+			continue
+		}
+
+		lower := max(b.Lower, h.min)
+		upper := min(b.Upper, h.max)
+
+		return interpolate(lower, upper, (rank-float64(below))/float64(b.Count))
+	}
+
+	return h.max
+}
+
+// Merge adds the counts of other to h.
+//
+// Returns [ErrIncompatibleLayout] if the layouts of both histograms differ.
+func (h *HistogramAccumulator) Merge(other *HistogramAccumulator) error {
+	if !slices.Equal(h.layout.boundaries, other.layout.boundaries) {
+		return ErrIncompatibleLayout
+	}
+
+	if other.count == 0 {
+		return nil
+	}
+
+	if h.count == 0 {
+		h.min, h.max = other.min, other.max
+	}
+
+	for i, count := range other.counts {
+		h.counts[i] += count
+	}
+
+	h.underflow += other.underflow
+	h.overflow += other.overflow
+	h.count += other.count
+	h.min = min(h.min, other.min)
+	h.max = max(h.max, other.max)
+
+	return nil
+}
+
+// String renders the histogram as ASCII bar chart, one line per bucket.
+// Underflow and overflow buckets are only shown, if they are not empty.
+func (h HistogramAccumulator) String() string {
+	if h.count == 0 {
+		return "(Histogram: <empty>)"
+	}
+
+	var (
+		labels   []string
+		buckets  []Bucket
+		maxCount int
+	)
+
+	for b := range h.Buckets() {
+		if b.Count == 0 && (math.IsInf(b.Lower, -1) || math.IsInf(b.Upper, 1)) {
+			continue
+		}
+
+		labels = append(labels, b.String())
+		buckets = append(buckets, b)
+		maxCount = max(maxCount, b.Count)
+	}
+
+	labelWidth := len(slices.MaxFunc(labels, func(a, b string) int { return len(a) - len(b) }))
+	countWidth := len(fmt.Sprint(maxCount))
+
+	var sb strings.Builder
+
+	for i, b := range buckets {
+		bar := strings.Repeat("#", b.Count*maxBarWidth/maxCount)
+		fmt.Fprintf(&sb, "%-*s %*d %s\n", labelWidth, labels[i], countWidth, b.Count, bar)
+	}
+
+	return sb.String()
+}
+
+type histogramJSON struct {
+	Boundaries []float64 `json:"boundaries"`
+	Counts     []int     `json:"counts"`
+	Underflow  int       `json:"underflow"`
+	Overflow   int       `json:"overflow"`
+	Min        *float64  `json:"min,omitempty"`
+	Max        *float64  `json:"max,omitempty"`
+}
+
+// MarshalJSON encodes the histogram with its boundaries and counts, so it can
+// be restored with [HistogramAccumulator.UnmarshalJSON] and merged later.
+func (h HistogramAccumulator) MarshalJSON() ([]byte, error) {
+	j := histogramJSON{
+		Boundaries: h.layout.boundaries,
+		Counts:     h.counts,
+		Underflow:  h.underflow,
+		Overflow:   h.overflow,
+	}
+
+	if h.count > 0 {
+		j.Min, j.Max = &h.min, &h.max
+	}
+
+	return json.Marshal(j)
+}
+
+// UnmarshalJSON decodes a histogram encoded by
+// [HistogramAccumulator.MarshalJSON].
+//
+// Returns [ErrIncompatibleLayout] if the boundaries are invalid or don't match
+// the number of counts, if any count is negative, or if min and max are
+// missing or min is greater than max for a non-empty histogram.
+func (h *HistogramAccumulator) UnmarshalJSON(d []byte) error {
+	var j histogramJSON
+
+	err := json.Unmarshal(d, &j)
+	if err != nil {
+		return err
+	}
+
+	err = validateBoundaries(j.Boundaries)
+	if err != nil {
+		return err
+	}
+
+	if len(j.Counts) != len(j.Boundaries)-1 {
+		return fmt.Errorf("%w: %d boundaries with %d counts",
+			ErrIncompatibleLayout, len(j.Boundaries), len(j.Counts))
+	}
+
+	count := j.Underflow + j.Overflow
+	negative := j.Underflow < 0 || j.Overflow < 0
+
+	for _, c := range j.Counts {
+		count += c
+		negative = negative || c < 0
+	}
+
+	if negative {
+		return fmt.Errorf("%w: counts must not be negative", ErrIncompatibleLayout)
+	}
+
+	if count > 0 && (j.Min == nil || j.Max == nil || !(*j.Min <= *j.Max)) {
+		return fmt.Errorf("%w: non-empty histogram requires min <= max", ErrIncompatibleLayout)
+	}
+
+	*h = HistogramAccumulator{
+		layout:    BucketLayout{boundaries: j.Boundaries},
+		counts:    j.Counts,
+		underflow: j.Underflow,
+		overflow:  j.Overflow,
+		count:     count,
+	}
+
+	if count > 0 {
+		h.min, h.max = *j.Min, *j.Max
+	}
+
+	return nil
+}
+
+func validateBoundaries(boundaries []float64) error {
+	if len(boundaries) < 2 { //nolint:mnd
+		return fmt.Errorf("%w: at least two boundaries are required", ErrIncompatibleLayout)
+	}
+
+	for i := 1; i < len(boundaries); i++ {
+		if !(boundaries[i] > boundaries[i-1]) {
+			return fmt.Errorf("%w: boundaries must be strictly increasing", ErrIncompatibleLayout)
+		}
+	}
+
+	return nil
+}
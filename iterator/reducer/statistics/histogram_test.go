@@ -0,0 +1,245 @@
+package statistics_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleHistogram() {
+	i := iterator.Of(-3, 1, 2, 2, 5, 7, 7, 7, 9, 12, 25)
+	acc := statistics.NewHistogramAccumulator(statistics.LinearBuckets(0, 5, 2))
+
+	iterator.Reduce(i, &acc, statistics.Histogram[int])
+
+	fmt.Print(acc.String())
+
+	// Output:
+	// (-Inf, 0)  1 ########
+	// [0, 5)     3 ########################
+	// [5, 10)    5 ########################################
+	// [10, +Inf) 2 ################
+}
+
+func TestBucketLayouts(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		layout statistics.BucketLayout
+		expect []float64
+	}
+
+	tests := []test{
+		{
+			name:   "linear",
+			layout: statistics.LinearBuckets(-10, 5, 4),
+			expect: []float64{-10, -5, 0, 5, 10},
+		},
+		{
+			name:   "exponential",
+			layout: statistics.ExponentialBuckets(1, 10, 3),
+			expect: []float64{1, 10, 100, 1000},
+		},
+		{
+			name:   "explicit",
+			layout: statistics.ExplicitBuckets(0.1, 0.5, 2.5),
+			expect: []float64{0.1, 0.5, 2.5},
+		},
+		{
+			name:   "log-linear",
+			layout: statistics.LogLinearBuckets(1, 7, 4),
+			expect: []float64{1, 1.25, 1.5, 1.75, 2, 2.5, 3, 3.5, 4, 5, 6, 7, 8},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Act
+			got := tc.layout.Boundaries()
+
+			// Assert
+			assert.InDeltaSlice(t, tc.expect, got, 1e-9)
+		})
+	}
+}
+
+func TestBucketLayouts_invalid(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { statistics.LinearBuckets(0, 0, 3) })
+	assert.Panics(t, func() { statistics.LinearBuckets(0, 1, 0) })
+	assert.Panics(t, func() { statistics.ExponentialBuckets(0, 2, 3) })
+	assert.Panics(t, func() { statistics.ExponentialBuckets(1, 1, 3) })
+	assert.Panics(t, func() { statistics.ExplicitBuckets(1) })
+	assert.Panics(t, func() { statistics.ExplicitBuckets(1, 3, 2) })
+	assert.Panics(t, func() { statistics.LogLinearBuckets(1, 1, 4) })
+	assert.Panics(t, func() { statistics.NewHistogramAccumulator(statistics.BucketLayout{}) })
+}
+
+func TestHistogram_buckets(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewHistogramAccumulator(statistics.ExplicitBuckets(0, 1, 10))
+
+	// Act
+	iterator.Reduce(iterator.Of(-1, 0, 0.5, 1, 9.99, 10, 11, math.NaN()), &acc, statistics.Histogram[float64])
+
+	// Assert
+	assert.Equal(t, 7, acc.Count())
+	assert.Equal(t, 1, acc.Underflow())
+	assert.Equal(t, 2, acc.Overflow())
+
+	assert.Equal(t, []statistics.Bucket{
+		{Lower: math.Inf(-1), Upper: 0, Count: 1},
+		{Lower: 0, Upper: 1, Count: 2},
+		{Lower: 1, Upper: 10, Count: 2},
+		{Lower: 10, Upper: math.Inf(1), Count: 2},
+	}, slices.Collect(acc.Buckets()))
+
+	assert.Equal(t, []statistics.Bucket{
+		{Lower: math.Inf(-1), Upper: 0, Count: 1},
+		{Lower: 0, Upper: 1, Count: 3},
+		{Lower: 1, Upper: 10, Count: 5},
+		{Lower: 10, Upper: math.Inf(1), Count: 7},
+	}, slices.Collect(acc.Cumulative()))
+}
+
+func TestHistogram_Quantile(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewHistogramAccumulator(statistics.LogLinearBuckets(1, 10_000, 32))
+
+	iterator.Reduce(iterator.FromToInclusive(1, 10_000), &acc, statistics.Histogram[int])
+
+	// Act & Assert
+	assert.InDelta(t, 1.0, acc.Quantile(0), 0)
+	assert.InDelta(t, 10_000.0, acc.Quantile(1), 0)
+
+	for _, q := range []float64{0.1, 0.5, 0.9, 0.99} {
+		assert.InEpsilon(t, q*10_000, acc.Quantile(q), 1.0/32)
+	}
+}
+
+func TestHistogram_Quantile_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewHistogramAccumulator(statistics.LinearBuckets(0, 1, 10))
+
+	// Act & Assert
+	assert.True(t, math.IsNaN(acc.Quantile(0.5)))
+	assert.Equal(t, "(Histogram: <empty>)", acc.String())
+}
+
+func TestHistogram_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	layout := statistics.LinearBuckets(0, 10, 10)
+
+	a := statistics.NewHistogramAccumulator(layout)
+	b := statistics.NewHistogramAccumulator(layout)
+	all := statistics.NewHistogramAccumulator(layout)
+
+	iterator.Reduce(iterator.FromTo(-5, 50), &a, statistics.Histogram[int])
+	iterator.Reduce(iterator.FromTo(50, 120), &b, statistics.Histogram[int])
+	iterator.Reduce(iterator.FromTo(-5, 120), &all, statistics.Histogram[int])
+
+	// Act
+	err := a.Merge(&b)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, all, a)
+}
+
+func TestHistogram_Merge_incompatible(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := statistics.NewHistogramAccumulator(statistics.LinearBuckets(0, 10, 10))
+	b := statistics.NewHistogramAccumulator(statistics.LinearBuckets(0, 10, 11))
+
+	// Act
+	err := a.Merge(&b)
+
+	// Assert
+	assert.ErrorIs(t, err, statistics.ErrIncompatibleLayout)
+}
+
+func TestHistogram_JSON(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewHistogramAccumulator(statistics.ExplicitBuckets(0, 1, 10))
+	iterator.Reduce(iterator.Of(-1, 0.5, 5, 20), &acc, statistics.Histogram[float64])
+
+	// Act
+	data, err := json.Marshal(acc)
+	require.NoError(t, err)
+
+	var decoded statistics.HistogramAccumulator
+	err = json.Unmarshal(data, &decoded)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.JSONEq(t,
+		`{"boundaries":[0,1,10],"counts":[1,1],"underflow":1,"overflow":1,"min":-1,"max":20}`,
+		string(data))
+	assert.Equal(t, acc, decoded)
+}
+
+func TestHistogram_UnmarshalJSON_invalid(t *testing.T) {
+	t.Parallel()
+
+	inputs := []string{
+		`{"boundaries":[0,1,10],"counts":[1]}`,
+		`{"boundaries":[0,10,1],"counts":[1,1]}`,
+		`{"boundaries":[0],"counts":[]}`,
+		`{"boundaries":[0,1],"counts":[-1],"min":0,"max":1}`,
+		`{"boundaries":[0,1],"counts":[2],"underflow":-1,"min":0,"max":1}`,
+		`{"boundaries":[0,1],"counts":[1],"overflow":-1,"min":0,"max":1}`,
+		`{"boundaries":[0,1],"counts":[1]}`,
+		`{"boundaries":[0,1],"counts":[1],"min":0}`,
+		`{"boundaries":[0,1],"counts":[0],"underflow":1,"max":-1}`,
+		`{"boundaries":[0,1],"counts":[2],"min":0.8,"max":0.2}`,
+	}
+
+	for _, input := range inputs {
+		t.Run(input, func(t *testing.T) {
+			// Arrange
+			var acc statistics.HistogramAccumulator
+
+			// Act
+			err := json.Unmarshal([]byte(input), &acc)
+
+			// Assert
+			assert.ErrorIs(t, err, statistics.ErrIncompatibleLayout)
+		})
+	}
+}
+
+func TestHistogram_UnmarshalJSON_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var acc statistics.HistogramAccumulator
+
+	// Act
+	err := json.Unmarshal([]byte(`{"boundaries":[0,1],"counts":[0]}`), &acc)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, statistics.NewHistogramAccumulator(statistics.ExplicitBuckets(0, 1)), acc)
+}
@@ -0,0 +1,273 @@
+package statistics
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math"
+	"math/bits"
+	"reflect"
+)
+
+// Precision limits and default of a [HyperLogLog].
+const (
+	MinPrecision     = 4
+	MaxPrecision     = 18
+	DefaultPrecision = 14
+)
+
+// hyperLogLogVersion is the first byte of the binary encoding, so the format
+// can be changed later without misinterpreting old data.
+const hyperLogLogVersion = 1
+
+// ErrIncompatiblePrecision is returned when merging or decoding HyperLogLog
+// sketches with different or invalid precisions.
+var ErrIncompatiblePrecision = errors.New("incompatible HyperLogLog precision")
+
+// ErrInvalidEncoding is returned when decoding malformed binary data.
+var ErrInvalidEncoding = errors.New("invalid binary encoding")
+
+// DistinctCount is a [iterator.Reducer], which estimates the number of
+// distinct values of a stream with a [HyperLogLog] in constant memory.
+//
+// Strings, booleans and numeric types (also named ones) are hashed by their
+// value, which is stable across processes, so sketches can be stored and merged
+// later. All other types are hashed by their representation created by fmt's
+// '%#v' verb, which is only stable for plain values: for pointers, channels or
+// structs and arrays containing them, it contains memory addresses, so sketches
+// of such values must not be merged across processes. For custom hashing use
+// [HyperLogLog.AddHash].
+func DistinctCount[T comparable](acc *HyperLogLog, in T) {
+	acc.AddHash(stableHash(in))
+}
+
+// HyperLogLog is a sketch for estimating the number of distinct values, as
+// described by Flajolet et al., using 64-bit hashes like HyperLogLog++.
+//
+// It is no full HyperLogLog++: instead of its empirical bias correction, it
+// uses the improved estimator by Otmar Ertl, which is unbiased also for small
+// and intermediate cardinalities, and it has no sparse representation, so even
+// sketches of few values use the full memory.
+//
+// A sketch with precision p uses 2ᵖ bytes of memory, independent of the number
+// of values. The standard error of the estimate is 1.04/√(2ᵖ), e.g. 0.81% for
+// the default precision 14 (16 KiB), and about 99.7% of the estimates are
+// within three times the standard error.
+//
+// The zero value is an empty HyperLogLog using [DefaultPrecision].
+type HyperLogLog struct {
+	precision uint8
+	registers []uint8
+}
+
+// NewHyperLogLog creates a new empty [HyperLogLog] with the given precision.
+//
+// Panics if precision is not within [MinPrecision, MaxPrecision].
+func NewHyperLogLog(precision uint8) HyperLogLog {
+	if precision < MinPrecision || precision > MaxPrecision {
+		panic(fmt.Sprintf("precision must be within [%d, %d]", MinPrecision, MaxPrecision))
+	}
+
+	return HyperLogLog{
+		precision: precision,
+		registers: make([]uint8, 1<<precision),
+	}
+}
+
+// Precision returns the precision of the sketch.
+func (h *HyperLogLog) Precision() uint8 {
+	h.init()
+
+	return h.precision
+}
+
+// AddHash adds a value to the sketch by its 64-bit hash. The hash must be
+// uniformly distributed.
+func (h *HyperLogLog) AddHash(hash uint64) {
+	h.init()
+
+	index := hash >> (64 - h.precision)
+	rest := hash<<h.precision | 1<<(h.precision-1)
+	rank := uint8(bits.LeadingZeros64(rest)) + 1 //nolint:gosec // at most 64
+
+	h.registers[index] = max(h.registers[index], rank)
+}
+
+// Count returns the estimated number of distinct values added.
+func (h *HyperLogLog) Count() int {
+	h.init()
+
+	m := float64(len(h.registers))
+	q := 64 - int(h.precision)
+
+	histogram := make([]float64, q+2) //nolint:mnd
+	for _, r := range h.registers {
+		histogram[r]++
+	}
+
+	if histogram[0] == m {
+		return 0
+	}
+
+	z := m * ertlTau(1-histogram[q+1]/m)
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + histogram[k]) //nolint:mnd
+	}
+
+	z += m * ertlSigma(histogram[0]/m)
+
+	return int(math.Round(m * m / (2 * math.Ln2 * z))) //nolint:mnd
+}
+
+// Merge combines other into h, so h estimates the number of distinct values
+// added to either of both.
+//
+// Returns [ErrIncompatiblePrecision] if the precisions differ.
+func (h *HyperLogLog) Merge(other *HyperLogLog) error {
+	h.init()
+	other.init()
+
+	if h.precision != other.precision {
+		return ErrIncompatiblePrecision
+	}
+
+	for i, r := range other.registers {
+		h.registers[i] = max(h.registers[i], r)
+	}
+
+	return nil
+}
+
+// MarshalBinary encodes the sketch, so it can be restored with
+// [HyperLogLog.UnmarshalBinary].
+func (h *HyperLogLog) MarshalBinary() ([]byte, error) {
+	h.init()
+
+	data := make([]byte, 0, 2+len(h.registers)) //nolint:mnd
+	data = append(data, hyperLogLogVersion, h.precision)
+	data = append(data, h.registers...)
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes a sketch encoded by [HyperLogLog.MarshalBinary].
+func (h *HyperLogLog) UnmarshalBinary(data []byte) error {
+	if len(data) < 2 || data[0] != hyperLogLogVersion { //nolint:mnd
+		return ErrInvalidEncoding
+	}
+
+	precision := data[1]
+	if precision < MinPrecision || precision > MaxPrecision {
+		return ErrIncompatiblePrecision
+	}
+
+	registers := data[2:]
+	if len(registers) != 1<<precision {
+		return fmt.Errorf("%w: expected %d registers, got %d", ErrInvalidEncoding, 1<<precision, len(registers))
+	}
+
+	for _, r := range registers {
+		if int(r) > 64-int(precision)+1 {
+			return fmt.Errorf("%w: register value %d out of range", ErrInvalidEncoding, r)
+		}
+	}
+
+	h.precision = precision
+	h.registers = append(make([]uint8, 0, len(registers)), registers...)
+
+	return nil
+}
+
+func (h *HyperLogLog) init() {
+	if h.registers == nil {
+		*h = NewHyperLogLog(DefaultPrecision)
+	}
+}
+
+func ertlSigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+
+	y := 1.0
+	z := x
+
+	for {
+		x *= x
+		previous := z
+		z += x * y
+		y += y
+
+		if z == previous {
+			return z
+		}
+	}
+}
+
+func ertlTau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+
+	y := 1.0
+	z := 1 - x
+
+	for {
+		x = math.Sqrt(x)
+		previous := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+
+		if z == previous {
+			return z / 3 //nolint:mnd
+		}
+	}
+}
+
+// stableHash hashes v, independent of the process for plain values, see
+// [DistinctCount].
+func stableHash[T comparable](v T) uint64 {
+	rv := reflect.ValueOf(v)
+
+	//nolint:exhaustive // all other kinds are handled by default
+	switch rv.Kind() {
+	case reflect.String:
+		return hashBytes([]byte(rv.String()))
+	case reflect.Bool:
+		if rv.Bool() {
+			return mix64(1)
+		}
+
+		return mix64(0)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return mix64(uint64(rv.Int())) //nolint:gosec // Reinterpreting the bits is intended
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return mix64(rv.Uint())
+	case reflect.Float32, reflect.Float64:
+		return mix64(math.Float64bits(rv.Float()))
+	default:
+		return hashBytes(fmt.Appendf(nil, "%#v", v))
+	}
+}
+
+func hashBytes(b []byte) uint64 {
+	h := fnv.New64a()
+	_, _ = h.Write(b)
+
+	var length [8]byte
+	binary.LittleEndian.PutUint64(length[:], uint64(len(b)))
+	_, _ = h.Write(length[:])
+
+	return mix64(h.Sum64())
+}
+
+// mix64 is the SplitMix64 mixing function, spreading the bits of x evenly over
+// the result.
+func mix64(x uint64) uint64 {
+	x += 0x9e3779b97f4a7c15
+	x = (x ^ (x >> 30)) * 0xbf58476d1ce4e5b9 //nolint:mnd
+	x = (x ^ (x >> 27)) * 0x94d049bb133111eb //nolint:mnd
+
+	return x ^ (x >> 31) //nolint:mnd
+}
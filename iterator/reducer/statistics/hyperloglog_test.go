@@ -0,0 +1,177 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleDistinctCount() {
+	ips := iterator.Of("10.0.0.1", "10.0.0.2", "10.0.0.1", "10.0.0.3", "10.0.0.2")
+
+	acc := statistics.HyperLogLog{}
+	iterator.Reduce(ips, &acc, statistics.DistinctCount[string])
+
+	fmt.Println(acc.Count())
+
+	// Output: 3
+}
+
+func TestDistinctCount_errorBound(t *testing.T) {
+	t.Parallel()
+
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(4))
+
+	for _, precision := range []uint8{statistics.MinPrecision, 10, statistics.DefaultPrecision} {
+		for _, n := range []int{10, 100, 1_000, 10_000, 100_000} {
+			t.Run(fmt.Sprintf("p=%d,n=%d", precision, n), func(t *testing.T) {
+				// Arrange
+				exact := set.Of[int]()
+				sketch := statistics.NewHyperLogLog(precision)
+
+				// Each distinct value appears multiple times on average.
+				values := make([]int, 0, 3*n)
+				for range 3 * n {
+					values = append(values, rand.Intn(4*n/3))
+				}
+
+				// Act
+				iterator.Reduce(iterator.Of(values...), &sketch, statistics.DistinctCount[int])
+				exact.Add(values...)
+
+				// Assert
+				standardError := 1.04 / math.Sqrt(float64(int(1)<<precision))
+				assert.InEpsilon(t, exact.Len(), sketch.Count(), 3*standardError)
+			})
+		}
+	}
+}
+
+func TestDistinctCount_types(t *testing.T) {
+	t.Parallel()
+
+	type userID int64
+
+	type point struct{ X, Y int }
+
+	// Act
+	ids := statistics.HyperLogLog{}
+	iterator.Reduce(iterator.Of[userID](1, 2, 2, 3, 1), &ids, statistics.DistinctCount[userID])
+
+	points := statistics.HyperLogLog{}
+	iterator.Reduce(iterator.Of(point{1, 2}, point{2, 1}, point{1, 2}), &points, statistics.DistinctCount[point])
+
+	empty := statistics.HyperLogLog{}
+
+	// Assert
+	assert.Equal(t, 3, ids.Count())
+	assert.Equal(t, 2, points.Count())
+	assert.Equal(t, 0, empty.Count())
+	assert.Equal(t, uint8(statistics.DefaultPrecision), empty.Precision())
+}
+
+func TestHyperLogLog_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	shards := []statistics.HyperLogLog{{}, {}, {}}
+	exact := set.Of[string]()
+
+	for i := range 30_000 {
+		v := "user-" + strconv.Itoa(i%20_000)
+		statistics.DistinctCount(&shards[i%len(shards)], v)
+		exact.Add(v)
+	}
+
+	// Act
+	merged := statistics.HyperLogLog{}
+	for i := range shards {
+		require.NoError(t, merged.Merge(&shards[i]))
+	}
+
+	// Assert
+	assert.InEpsilon(t, exact.Len(), merged.Count(), 3*1.04/128)
+}
+
+func TestHyperLogLog_Merge_incompatible(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := statistics.NewHyperLogLog(10)
+	b := statistics.NewHyperLogLog(12)
+
+	// Act
+	err := a.Merge(&b)
+
+	// Assert
+	assert.ErrorIs(t, err, statistics.ErrIncompatiblePrecision)
+}
+
+func TestHyperLogLog_Binary(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	sketch := statistics.NewHyperLogLog(8)
+	iterator.Reduce(iterator.FromTo(0, 1000), &sketch, statistics.DistinctCount[int])
+
+	// Act
+	data, err := sketch.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded statistics.HyperLogLog
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Len(t, data, 2+256)
+	assert.Equal(t, sketch, decoded)
+	assert.Equal(t, sketch.Count(), decoded.Count())
+}
+
+func TestHyperLogLog_UnmarshalBinary_invalid(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		data   []byte
+		expect error
+	}
+
+	tests := []test{
+		{"empty", []byte{}, statistics.ErrInvalidEncoding},
+		{"unknown version", append([]byte{2, 4}, make([]byte, 16)...), statistics.ErrInvalidEncoding},
+		{"invalid precision", append([]byte{1, 3}, make([]byte, 8)...), statistics.ErrIncompatiblePrecision},
+		{"wrong number of registers", append([]byte{1, 4}, make([]byte, 15)...), statistics.ErrInvalidEncoding},
+		{"register out of range", append([]byte{1, 4, 62}, make([]byte, 15)...), statistics.ErrInvalidEncoding},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			var sketch statistics.HyperLogLog
+
+			// Act
+			err := sketch.UnmarshalBinary(tc.data)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expect)
+		})
+	}
+}
+
+func TestNewHyperLogLog_invalidPrecision(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { statistics.NewHyperLogLog(statistics.MinPrecision - 1) })
+	assert.Panics(t, func() { statistics.NewHyperLogLog(statistics.MaxPrecision + 1) })
+}
@@ -0,0 +1,241 @@
+package statistics
+
+import (
+	"errors"
+	"math"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer"
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+// ErrNoValues is returned when a result is requested from an accumulator,
+// which did not gather any values.
+var ErrNoValues = errors.New("no values gathered")
+
+// ErrNegativeValue is returned when a result is requested, which is undefined
+// for negative values, but negative values were gathered.
+var ErrNegativeValue = errors.New("negative value gathered")
+
+// ErrNegativeWeight is returned by [WeightedMeanAccumulator.Mean], when a
+// negative weight was gathered.
+var ErrNegativeWeight = errors.New("negative weight gathered")
+
+// ErrZeroWeight is returned by [WeightedMeanAccumulator.Mean], when the sum of
+// all gathered weights is zero.
+var ErrZeroWeight = errors.New("sum of weights is zero")
+
+// GeometricMean is a [iterator.Reducer], which collects the geometric mean of a
+// stream, the n-th root of the product of n values.
+//
+// The logarithms of the values are summed up instead of the values themselves,
+// so the product can neither overflow nor underflow.
+func GeometricMean[T constraints.RealNumber](acc *GeometricMeanAccumulator, in T) {
+	acc.count++
+
+	switch {
+	case in < 0:
+		acc.negatives++
+	case in == 0:
+		acc.zeros++
+	default:
+		reducer.NeumaierSum(&acc.logSum, math.Log(float64(in)))
+	}
+}
+
+// GeometricMeanAccumulator is the accumulator type for the [GeometricMean]
+// reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type GeometricMeanAccumulator struct {
+	logSum    reducer.CompensatedSumAccumulator[float64]
+	count     int
+	zeros     int
+	negatives int
+}
+
+// Count returns the number of gathered values.
+func (g GeometricMeanAccumulator) Count() int {
+	return g.count
+}
+
+// Mean returns the geometric mean of the gathered values. If any value was 0,
+// the result is 0.
+//
+// Returns [ErrNoValues] if no values were gathered and [ErrNegativeValue] if
+// any value was negative.
+func (g GeometricMeanAccumulator) Mean() (float64, error) {
+	switch {
+	case g.count == 0:
+		return math.NaN(), ErrNoValues
+	case g.negatives > 0:
+		return math.NaN(), ErrNegativeValue
+	case g.zeros > 0:
+		return 0, nil
+	}
+
+	return math.Exp(g.logSum.Sum() / float64(g.count)), nil
+}
+
+// Merge combines other into g, so g reflects the values gathered by both.
+func (g *GeometricMeanAccumulator) Merge(other *GeometricMeanAccumulator) {
+	reducer.NeumaierSum(&g.logSum, other.logSum.Sum())
+	g.count += other.count
+	g.zeros += other.zeros
+	g.negatives += other.negatives
+}
+
+// HarmonicMean is a [iterator.Reducer], which collects the harmonic mean of a
+// stream, the reciprocal of the arithmetic mean of the reciprocals.
+func HarmonicMean[T constraints.RealNumber](acc *HarmonicMeanAccumulator, in T) {
+	acc.count++
+
+	switch {
+	case in < 0:
+		acc.negatives++
+	case in == 0:
+		acc.zeros++
+	default:
+		reducer.NeumaierSum(&acc.reciprocalSum, 1/float64(in))
+	}
+}
+
+// HarmonicMeanAccumulator is the accumulator type for the [HarmonicMean]
+// reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type HarmonicMeanAccumulator struct {
+	reciprocalSum reducer.CompensatedSumAccumulator[float64]
+	count         int
+	zeros         int
+	negatives     int
+}
+
+// Count returns the number of gathered values.
+func (h HarmonicMeanAccumulator) Count() int {
+	return h.count
+}
+
+// Mean returns the harmonic mean of the gathered values. If any value was 0,
+// the result is 0, which is the limit for a value approaching 0.
+//
+// Returns [ErrNoValues] if no values were gathered and [ErrNegativeValue] if
+// any value was negative.
+func (h HarmonicMeanAccumulator) Mean() (float64, error) {
+	switch {
+	case h.count == 0:
+		return math.NaN(), ErrNoValues
+	case h.negatives > 0:
+		return math.NaN(), ErrNegativeValue
+	case h.zeros > 0:
+		return 0, nil
+	}
+
+	return float64(h.count) / h.reciprocalSum.Sum(), nil
+}
+
+// Merge combines other into h, so h reflects the values gathered by both.
+func (h *HarmonicMeanAccumulator) Merge(other *HarmonicMeanAccumulator) {
+	reducer.NeumaierSum(&h.reciprocalSum, other.reciprocalSum.Sum())
+	h.count += other.count
+	h.zeros += other.zeros
+	h.negatives += other.negatives
+}
+
+// WeightedMean is a [iterator.Reducer], which collects the weighted arithmetic
+// mean of a stream of pairs of value and weight.
+//
+// To reduce an [iter.Seq2] of values and weights, it can be converted with
+// [iterator.Combine].
+func WeightedMean[V, W constraints.RealNumber](acc *WeightedMeanAccumulator, in tuple.Pair[V, W]) {
+	value, weight := float64(in.First()), float64(in.Second())
+
+	acc.count++
+
+	if weight < 0 {
+		acc.negativeWeights++
+	}
+
+	reducer.NeumaierSum(&acc.weightedSum, value*weight)
+	reducer.NeumaierSum(&acc.weightSum, weight)
+}
+
+// WeightedMeanAccumulator is the accumulator type for the [WeightedMean]
+// reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type WeightedMeanAccumulator struct {
+	weightedSum     reducer.CompensatedSumAccumulator[float64]
+	weightSum       reducer.CompensatedSumAccumulator[float64]
+	count           int
+	negativeWeights int
+}
+
+// Count returns the number of gathered pairs.
+func (w WeightedMeanAccumulator) Count() int {
+	return w.count
+}
+
+// Mean returns the weighted mean of the gathered values.
+//
+// Returns [ErrNoValues] if no values were gathered, [ErrNegativeWeight] if any
+// weight was negative and [ErrZeroWeight] if all weights were zero.
+func (w WeightedMeanAccumulator) Mean() (float64, error) {
+	switch {
+	case w.count == 0:
+		return math.NaN(), ErrNoValues
+	case w.negativeWeights > 0:
+		return math.NaN(), ErrNegativeWeight
+	case w.weightSum.Sum() == 0:
+		return math.NaN(), ErrZeroWeight
+	}
+
+	return w.weightedSum.Sum() / w.weightSum.Sum(), nil
+}
+
+// Merge combines other into w, so w reflects the pairs gathered by both.
+func (w *WeightedMeanAccumulator) Merge(other *WeightedMeanAccumulator) {
+	reducer.NeumaierSum(&w.weightedSum, other.weightedSum.Sum())
+	reducer.NeumaierSum(&w.weightSum, other.weightSum.Sum())
+	w.count += other.count
+	w.negativeWeights += other.negativeWeights
+}
+
+// RootMeanSquare is a [iterator.Reducer], which collects the root mean square
+// (quadratic mean) of a stream.
+func RootMeanSquare[T constraints.RealNumber](acc *RootMeanSquareAccumulator, in T) {
+	acc.count++
+
+	reducer.NeumaierSum(&acc.squareSum, float64(in)*float64(in))
+}
+
+// RootMeanSquareAccumulator is the accumulator type for the [RootMeanSquare]
+// reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type RootMeanSquareAccumulator struct {
+	squareSum reducer.CompensatedSumAccumulator[float64]
+	count     int
+}
+
+// Count returns the number of gathered values.
+func (r RootMeanSquareAccumulator) Count() int {
+	return r.count
+}
+
+// RootMeanSquare returns the root mean square of the gathered values.
+//
+// Returns [ErrNoValues] if no values were gathered.
+func (r RootMeanSquareAccumulator) RootMeanSquare() (float64, error) {
+	if r.count == 0 {
+		return math.NaN(), ErrNoValues
+	}
+
+	return math.Sqrt(r.squareSum.Sum() / float64(r.count)), nil
+}
+
+// Merge combines other into r, so r reflects the values gathered by both.
+func (r *RootMeanSquareAccumulator) Merge(other *RootMeanSquareAccumulator) {
+	reducer.NeumaierSum(&r.squareSum, other.squareSum.Sum())
+	r.count += other.count
+}
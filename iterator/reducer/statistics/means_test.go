@@ -0,0 +1,307 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+func ExampleGeometricMean() {
+	growthFactors := iterator.Of(1.10, 0.95, 1.20, 1.05)
+
+	acc := statistics.GeometricMeanAccumulator{}
+	iterator.Reduce(growthFactors, &acc, statistics.GeometricMean[float64])
+
+	mean, err := acc.Mean()
+
+	fmt.Printf("%.4f %v\n", mean, err)
+
+	// Output: 1.0712 <nil>
+}
+
+func ExampleHarmonicMean() {
+	speeds := iterator.Of(60, 40)
+
+	acc := statistics.HarmonicMeanAccumulator{}
+	iterator.Reduce(speeds, &acc, statistics.HarmonicMean[int])
+
+	mean, err := acc.Mean()
+
+	fmt.Printf("%.2f %v\n", mean, err)
+
+	// Output: 48.00 <nil>
+}
+
+func ExampleWeightedMean() {
+	grades := map[string]tuple.Pair[float64, int]{
+		"exam":     tuple.PairOf(2.0, 3),
+		"homework": tuple.PairOf(1.0, 1),
+	}
+
+	acc := statistics.WeightedMeanAccumulator{}
+	for _, grade := range grades {
+		statistics.WeightedMean(&acc, grade)
+	}
+
+	mean, err := acc.Mean()
+
+	fmt.Println(mean, err)
+
+	// Output: 1.75 <nil>
+}
+
+func ExampleRootMeanSquare() {
+	i := iterator.Of(3, -4, 3, -4)
+
+	acc := statistics.RootMeanSquareAccumulator{}
+	iterator.Reduce(i, &acc, statistics.RootMeanSquare[int])
+
+	rms, err := acc.RootMeanSquare()
+
+	fmt.Printf("%.4f %v\n", rms, err)
+
+	// Output: 3.5355 <nil>
+}
+
+//nolint:funlen
+func TestGeometricAndHarmonicMean(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name           string
+		input          []float64
+		expectGeo      float64
+		expectHarmonic float64
+		expectErr      error
+	}
+
+	tests := []test{
+		{"single", []float64{5}, 5, 5, nil},
+		{"powers of two", []float64{1, 2, 4, 8}, 2 * math.Sqrt2, 4 / 1.875, nil},
+		{"equal values", []float64{3, 3, 3}, 3, 3, nil},
+		{"huge values do not overflow", []float64{1e300, 1e300, 1e300}, 1e300, 1e300, nil},
+		{"tiny values do not underflow", []float64{1e-300, 1e-300}, 1e-300, 1e-300, nil},
+		{"zero", []float64{1, 0, 4}, 0, 0, nil},
+		{"negative", []float64{1, -2, 4}, math.NaN(), math.NaN(), statistics.ErrNegativeValue},
+		{"negative and zero", []float64{0, -2}, math.NaN(), math.NaN(), statistics.ErrNegativeValue},
+		{"empty", nil, math.NaN(), math.NaN(), statistics.ErrNoValues},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			geo := statistics.GeometricMeanAccumulator{}
+			harmonic := statistics.HarmonicMeanAccumulator{}
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &geo, statistics.GeometricMean[float64])
+			iterator.Reduce(iterator.Of(tc.input...), &harmonic, statistics.HarmonicMean[float64])
+
+			geoMean, geoErr := geo.Mean()
+			harmonicMean, harmonicErr := harmonic.Mean()
+
+			// Assert
+			assert.Equal(t, len(tc.input), geo.Count())
+			assert.Equal(t, len(tc.input), harmonic.Count())
+
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, geoErr, tc.expectErr)
+				assert.ErrorIs(t, harmonicErr, tc.expectErr)
+				assert.True(t, math.IsNaN(geoMean))
+				assert.True(t, math.IsNaN(harmonicMean))
+
+				return
+			}
+
+			require.NoError(t, geoErr)
+			require.NoError(t, harmonicErr)
+			assertRelativelyEqual(t, tc.expectGeo, geoMean)
+			assertRelativelyEqual(t, tc.expectHarmonic, harmonicMean)
+		})
+	}
+}
+
+func assertRelativelyEqual(t *testing.T, expect, actual float64) {
+	t.Helper()
+
+	if expect == 0 {
+		assert.InDelta(t, expect, actual, 0)
+
+		return
+	}
+
+	assert.InEpsilon(t, expect, actual, 1e-12)
+}
+
+func TestWeightedMean(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		input     []tuple.Pair[int, float64]
+		expect    float64
+		expectErr error
+	}
+
+	tests := []test{
+		{"equal weights", []tuple.Pair[int, float64]{tuple.PairOf(1, 1.0), tuple.PairOf(3, 1.0)}, 2, nil},
+		{"different weights", []tuple.Pair[int, float64]{tuple.PairOf(80, 1.0), tuple.PairOf(90, 3.0)}, 87.5, nil},
+		{"zero weight ignored", []tuple.Pair[int, float64]{tuple.PairOf(5, 2.0), tuple.PairOf(100, 0.0)}, 5, nil},
+		{"empty", nil, 0, statistics.ErrNoValues},
+		{"all weights zero", []tuple.Pair[int, float64]{tuple.PairOf(5, 0.0)}, 0, statistics.ErrZeroWeight},
+		{"negative weight", []tuple.Pair[int, float64]{tuple.PairOf(5, 2.0), tuple.PairOf(1, -1.0)}, 0, statistics.ErrNegativeWeight},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			acc := statistics.WeightedMeanAccumulator{}
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &acc, statistics.WeightedMean[int, float64])
+			mean, err := acc.Mean()
+
+			// Assert
+			if tc.expectErr != nil {
+				assert.ErrorIs(t, err, tc.expectErr)
+				assert.True(t, math.IsNaN(mean))
+
+				return
+			}
+
+			require.NoError(t, err)
+			assert.InDelta(t, tc.expect, mean, 1e-12)
+		})
+	}
+}
+
+func TestWeightedMean_seq2(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	weights := map[float64]int{1.5: 2, 3: 1}
+
+	// Act
+	acc := statistics.WeightedMeanAccumulator{}
+	iterator.Reduce(iterator.Combine(func(yield func(float64, int) bool) {
+		for v, w := range weights {
+			if !yield(v, w) {
+				return
+			}
+		}
+	}), &acc, statistics.WeightedMean[float64, int])
+
+	mean, err := acc.Mean()
+
+	// Assert
+	require.NoError(t, err)
+	assert.InDelta(t, 2.0, mean, 1e-12)
+}
+
+func TestRootMeanSquare(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.RootMeanSquareAccumulator{}
+
+	// Act
+	_, emptyErr := acc.RootMeanSquare()
+
+	iterator.Reduce(iterator.Of[int8](-128, 127, 0), &acc, statistics.RootMeanSquare[int8])
+	rms, err := acc.RootMeanSquare()
+
+	// Assert
+	require.ErrorIs(t, emptyErr, statistics.ErrNoValues)
+	require.NoError(t, err)
+	assert.InDelta(t, math.Sqrt((128.0*128+127*127)/3), rms, 1e-12)
+}
+
+//nolint:funlen
+func TestMeans_Merge(t *testing.T) {
+	t.Parallel()
+
+	values := []float64{4, 1, 9, 2.5, 7, 3, 8, 0.5}
+	left, right := iterator.Of(values[:3]...), iterator.Of(values[3:]...)
+	all := iterator.Of(values...)
+
+	t.Run("geometric", func(t *testing.T) {
+		t.Parallel()
+
+		a, b, expect := statistics.GeometricMeanAccumulator{}, statistics.GeometricMeanAccumulator{}, statistics.GeometricMeanAccumulator{}
+		iterator.Reduce(left, &a, statistics.GeometricMean[float64])
+		iterator.Reduce(right, &b, statistics.GeometricMean[float64])
+		iterator.Reduce(all, &expect, statistics.GeometricMean[float64])
+
+		a.Merge(&b)
+
+		assertMeansEqual(t, expect.Mean, a.Mean)
+		assert.Equal(t, expect.Count(), a.Count())
+	})
+
+	t.Run("harmonic", func(t *testing.T) {
+		t.Parallel()
+
+		a, b, expect := statistics.HarmonicMeanAccumulator{}, statistics.HarmonicMeanAccumulator{}, statistics.HarmonicMeanAccumulator{}
+		iterator.Reduce(left, &a, statistics.HarmonicMean[float64])
+		iterator.Reduce(right, &b, statistics.HarmonicMean[float64])
+		iterator.Reduce(all, &expect, statistics.HarmonicMean[float64])
+
+		a.Merge(&b)
+
+		assertMeansEqual(t, expect.Mean, a.Mean)
+		assert.Equal(t, expect.Count(), a.Count())
+	})
+
+	t.Run("weighted", func(t *testing.T) {
+		t.Parallel()
+
+		withWeights := func(v float64) tuple.Pair[float64, float64] { return tuple.PairOf(v, v/2) }
+
+		a, b, expect := statistics.WeightedMeanAccumulator{}, statistics.WeightedMeanAccumulator{}, statistics.WeightedMeanAccumulator{}
+		iterator.Reduce(iterator.Map(left, withWeights), &a, statistics.WeightedMean[float64, float64])
+		iterator.Reduce(iterator.Map(right, withWeights), &b, statistics.WeightedMean[float64, float64])
+		iterator.Reduce(iterator.Map(all, withWeights), &expect, statistics.WeightedMean[float64, float64])
+
+		a.Merge(&b)
+
+		assertMeansEqual(t, expect.Mean, a.Mean)
+		assert.Equal(t, expect.Count(), a.Count())
+	})
+
+	t.Run("root mean square", func(t *testing.T) {
+		t.Parallel()
+
+		a, b, expect := statistics.RootMeanSquareAccumulator{}, statistics.RootMeanSquareAccumulator{}, statistics.RootMeanSquareAccumulator{}
+		iterator.Reduce(left, &a, statistics.RootMeanSquare[float64])
+		iterator.Reduce(right, &b, statistics.RootMeanSquare[float64])
+		iterator.Reduce(all, &expect, statistics.RootMeanSquare[float64])
+
+		a.Merge(&b)
+
+		assertMeansEqual(t, expect.RootMeanSquare, a.RootMeanSquare)
+		assert.Equal(t, expect.Count(), a.Count())
+	})
+}
+
+func assertMeansEqual(t *testing.T, expect, actual func() (float64, error)) {
+	t.Helper()
+
+	expectMean, err := expect()
+	require.NoError(t, err)
+
+	actualMean, err := actual()
+	require.NoError(t, err)
+
+	assert.InEpsilon(t, expectMean, actualMean, 1e-12)
+}
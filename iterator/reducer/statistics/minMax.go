@@ -1,121 +1,114 @@
 package statistics
 
 import (
-	"fmt"
-	"math"
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/optional"
 )
 
-type minMax[T any] struct {
-	min T
-	max T
-}
-
-func (m minMax[T]) Min() T {
-	return m.min
-}
-
-func (m minMax[T]) Max() T {
-	return m.max
-}
-
-//nolint:unused // false positive
-func (m *minMax[T]) setMin(newMin T) {
-	m.min = newMin
+// MinMaxAccumulator is the accumulator type for the [MinMax] and [MinMaxFunc]
+// reducers. Additionally to the extreme values, it tracks their positions in
+// the stream.
+//
+// The zero value is an empty accumulator ready to use.
+type MinMaxAccumulator[T any] struct {
+	min      T
+	max      T
+	minIndex int
+	maxIndex int
+	count    int
+}
+
+// NewMinMaxAccumulator creates a new empty [MinMaxAccumulator].
+//
+// It is equivalent to the zero value and only kept for compatibility.
+func NewMinMaxAccumulator[T any]() MinMaxAccumulator[T] {
+	return MinMaxAccumulator[T]{}
+}
+
+// MinMaxFunc returns a [iterator.Reducer], which collects the minimum and
+// maximum value of a stream of arbitrary types, as defined by compare.
+//
+// compare must return a negative number if a < b, a positive number if a > b
+// and zero if both are equal, like [cmp.Compare]. If several values are equal
+// to the extreme, the first one is kept.
+func MinMaxFunc[T any](compare func(a, b T) int) iterator.Reducer[MinMaxAccumulator[T], T] {
+	return func(acc *MinMaxAccumulator[T], in T) {
+		acc.add(in, compare)
+	}
 }
 
-//nolint:unused // false positive
-func (m *minMax[T]) setMax(newMax T) {
-	m.max = newMax
+// Count returns the number of values gathered.
+func (m MinMaxAccumulator[T]) Count() int {
+	return m.count
 }
 
-func initMinMax[T comparable]() (MinMaxAccumulator[T], bool) {
-	var minMaxer any
-
-	var t T
-	switch any(t).(type) {
-	default:
-		panic(fmt.Sprintf("unsupported type %T", t))
-	case int:
-		minMaxer = intMinMax()
-	case int8:
-		minMaxer = int8MinMax()
-	case int16:
-		minMaxer = int16MinMax()
-	case int32:
-		minMaxer = int32MinMax()
-	case int64:
-		minMaxer = int64MinMax()
-	case uint:
-		minMaxer = uintMinMax()
-	case uint8:
-		minMaxer = uint8MinMax()
-	case uint16:
-		minMaxer = uint16MinMax()
-	case uint32:
-		minMaxer = uint32MinMax()
-	case uint64:
-		minMaxer = uint64MinMax()
-	case uintptr:
-		minMaxer = uintptrMinMax()
-	case float32:
-		minMaxer = float32MinMax()
-	case float64:
-		minMaxer = float64MinMax()
+// Min returns the minimum value or an empty optional if no values were
+// gathered.
+func (m MinMaxAccumulator[T]) Min() optional.Optional[T] {
+	if m.count == 0 {
+		return optional.Empty[T]()
 	}
 
-	mm, ok := minMaxer.(MinMaxAccumulator[T])
-
-	return mm, ok
+	return optional.Of(m.min)
 }
 
-func intMinMax() MinMaxAccumulator[int] {
-	return &minMax[int]{min: math.MaxInt, max: math.MinInt}
-}
+// Max returns the maximum value or an empty optional if no values were
+// gathered.
+func (m MinMaxAccumulator[T]) Max() optional.Optional[T] {
+	if m.count == 0 {
+		return optional.Empty[T]()
+	}
 
-func int8MinMax() MinMaxAccumulator[int8] {
-	return &minMax[int8]{min: math.MaxInt8, max: math.MinInt8}
+	return optional.Of(m.max)
 }
 
-func int16MinMax() MinMaxAccumulator[int16] {
-	return &minMax[int16]{min: math.MaxInt16, max: math.MinInt16}
-}
+// ArgMin returns the zero based position of the minimum value in the stream or
+// an empty optional if no values were gathered.
+func (m MinMaxAccumulator[T]) ArgMin() optional.Optional[int] {
+	if m.count == 0 {
+		return optional.Empty[int]()
+	}
 
-func int32MinMax() MinMaxAccumulator[int32] {
-	return &minMax[int32]{min: math.MaxInt32, max: math.MinInt32}
+	return optional.Of(m.minIndex)
 }
 
-func int64MinMax() MinMaxAccumulator[int64] {
-	return &minMax[int64]{min: math.MaxInt64, max: math.MinInt64}
-}
+// ArgMax returns the zero based position of the maximum value in the stream or
+// an empty optional if no values were gathered.
+func (m MinMaxAccumulator[T]) ArgMax() optional.Optional[int] {
+	if m.count == 0 {
+		return optional.Empty[int]()
+	}
 
-func uintMinMax() MinMaxAccumulator[uint] {
-	return &minMax[uint]{min: math.MaxUint, max: 0}
+	return optional.Of(m.maxIndex)
 }
 
-func uint8MinMax() MinMaxAccumulator[uint8] {
-	return &minMax[uint8]{min: math.MaxUint8, max: 0}
-}
+// Merge combines other into m, as if the values gathered by other were
+// gathered after the ones of m. compare must be the function used for
+// gathering, e.g. [cmp.Compare] for accumulators of [MinMax].
+func (m *MinMaxAccumulator[T]) Merge(other *MinMaxAccumulator[T], compare func(a, b T) int) {
+	if other.count == 0 {
+		return
+	}
 
-func uint16MinMax() MinMaxAccumulator[uint16] {
-	return &minMax[uint16]{min: math.MaxUint16, max: 0}
-}
+	if m.count == 0 || compare(other.min, m.min) < 0 {
+		m.min, m.minIndex = other.min, m.count+other.minIndex
+	}
 
-func uint32MinMax() MinMaxAccumulator[uint32] {
-	return &minMax[uint32]{min: math.MaxUint32, max: 0}
-}
+	if m.count == 0 || compare(other.max, m.max) > 0 {
+		m.max, m.maxIndex = other.max, m.count+other.maxIndex
+	}
 
-func uint64MinMax() MinMaxAccumulator[uint64] {
-	return &minMax[uint64]{min: math.MaxUint64, max: 0}
+	m.count += other.count
 }
 
-func uintptrMinMax() MinMaxAccumulator[uintptr] {
-	return &minMax[uintptr]{min: math.MaxUint, max: 0}
-}
+func (m *MinMaxAccumulator[T]) add(in T, compare func(a, b T) int) {
+	if m.count == 0 || compare(in, m.min) < 0 {
+		m.min, m.minIndex = in, m.count
+	}
 
-func float32MinMax() MinMaxAccumulator[float32] {
-	return &minMax[float32]{min: math.MaxFloat32, max: -math.MaxFloat32}
-}
+	if m.count == 0 || compare(in, m.max) > 0 {
+		m.max, m.maxIndex = in, m.count
+	}
 
-func float64MinMax() MinMaxAccumulator[float64] {
-	return &minMax[float64]{min: math.MaxFloat64, max: -math.MaxFloat64}
+	m.count++
 }
@@ -0,0 +1,189 @@
+package statistics_test
+
+import (
+	"cmp"
+	"fmt"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleMinMaxFunc() {
+	type city struct {
+		name       string
+		population int
+	}
+
+	cities := iterator.Of(
+		city{"Hamburg", 1_892_000},
+		city{"Berlin", 3_755_000},
+		city{"Bremen", 569_000},
+	)
+
+	acc := statistics.MinMaxAccumulator[city]{}
+	iterator.Reduce(cities, &acc, statistics.MinMaxFunc(func(a, b city) int {
+		return cmp.Compare(a.population, b.population)
+	}))
+
+	smallest, _ := acc.Min().Get()
+	largest, _ := acc.Max().Get()
+
+	fmt.Println("Smallest:", smallest.name)
+	fmt.Println("Largest:", largest.name)
+
+	// Output:
+	// Smallest: Bremen
+	// Largest: Berlin
+}
+
+func ExampleMinMaxAccumulator_ArgMax() {
+	temperatures := iterator.Of(12.5, 17.0, 21.5, 19.0, 21.5)
+
+	acc := statistics.MinMaxAccumulator[float64]{}
+	iterator.Reduce(temperatures, &acc, statistics.MinMax[float64])
+
+	fmt.Println(acc.ArgMin())
+	fmt.Println(acc.ArgMax())
+
+	// Output:
+	// (Optional[int]: 0)
+	// (Optional[int]: 2)
+}
+
+func TestMinMax_orderedTypes(t *testing.T) {
+	t.Parallel()
+
+	type celsius float64
+
+	t.Run("string", func(t *testing.T) {
+		t.Parallel()
+
+		acc := statistics.MinMaxAccumulator[string]{}
+		iterator.Reduce(iterator.Of("pear", "apple", "zucchini", "banana"), &acc, statistics.MinMax[string])
+
+		assert.Equal(t, optional.Of("apple"), acc.Min())
+		assert.Equal(t, optional.Of("zucchini"), acc.Max())
+	})
+
+	t.Run("named float", func(t *testing.T) {
+		t.Parallel()
+
+		acc := statistics.MinMaxAccumulator[celsius]{}
+		iterator.Reduce(iterator.Of[celsius](-3.5, 21, 4), &acc, statistics.MinMax[celsius])
+
+		assert.Equal(t, optional.Of[celsius](-3.5), acc.Min())
+		assert.Equal(t, optional.Of[celsius](21), acc.Max())
+	})
+
+	t.Run("extreme values", func(t *testing.T) {
+		t.Parallel()
+
+		acc := statistics.MinMaxAccumulator[int8]{}
+		iterator.Reduce(iterator.Of[int8](math.MaxInt8, math.MinInt8), &acc, statistics.MinMax[int8])
+
+		assert.Equal(t, optional.Of[int8](math.MinInt8), acc.Min())
+		assert.Equal(t, optional.Of[int8](math.MaxInt8), acc.Max())
+	})
+}
+
+func TestMinMaxFunc_time(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	base := time.Date(2025, 3, 1, 12, 0, 0, 0, time.UTC)
+	times := iterator.Of(base, base.Add(-time.Hour), base.Add(2*time.Hour), base)
+
+	// Act
+	acc := statistics.MinMaxAccumulator[time.Time]{}
+	iterator.Reduce(times, &acc, statistics.MinMaxFunc(time.Time.Compare))
+
+	// Assert
+	assert.Equal(t, optional.Of(base.Add(-time.Hour)), acc.Min())
+	assert.Equal(t, optional.Of(base.Add(2*time.Hour)), acc.Max())
+	assert.Equal(t, optional.Of(1), acc.ArgMin())
+	assert.Equal(t, optional.Of(2), acc.ArgMax())
+	assert.Equal(t, 4, acc.Count())
+}
+
+func TestMinMax_ArgMinArgMax(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name         string
+		input        []int
+		expectArgMin optional.Optional[int]
+		expectArgMax optional.Optional[int]
+	}
+
+	tests := []test{
+		{"empty", nil, optional.Empty[int](), optional.Empty[int]()},
+		{"single", []int{7}, optional.Of(0), optional.Of(0)},
+		{"ascending", []int{1, 2, 3}, optional.Of(0), optional.Of(2)},
+		{"descending", []int{3, 2, 1}, optional.Of(2), optional.Of(0)},
+		{"ties keep first", []int{2, 1, 3, 1, 3}, optional.Of(1), optional.Of(2)},
+		{"all equal", []int{4, 4, 4}, optional.Of(0), optional.Of(0)},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			acc := statistics.MinMaxAccumulator[int]{}
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &acc, statistics.MinMax[int])
+
+			// Assert
+			assert.Equal(t, tc.expectArgMin, acc.ArgMin())
+			assert.Equal(t, tc.expectArgMax, acc.ArgMax())
+		})
+	}
+}
+
+func TestMinMaxAccumulator_Merge(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name  string
+		left  []int
+		right []int
+	}
+
+	tests := []test{
+		{"both empty", nil, nil},
+		{"left empty", nil, []int{3, 1, 2}},
+		{"right empty", []int{3, 1, 2}, nil},
+		{"extremes in right", []int{3, 4, 5}, []int{9, 1}},
+		{"extremes in left", []int{9, 1}, []int{3, 4, 5}},
+		{"ties keep left", []int{1, 5}, []int{5, 1}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			left := statistics.MinMaxAccumulator[int]{}
+			iterator.Reduce(iterator.Of(tc.left...), &left, statistics.MinMax[int])
+
+			right := statistics.MinMaxAccumulator[int]{}
+			iterator.Reduce(iterator.Of(tc.right...), &right, statistics.MinMax[int])
+
+			expect := statistics.MinMaxAccumulator[int]{}
+			iterator.Reduce(iterator.Concat(iterator.Of(tc.left...), iterator.Of(tc.right...)), &expect, statistics.MinMax[int])
+
+			// Act
+			left.Merge(&right, cmp.Compare[int])
+
+			// Assert
+			assert.Equal(t, expect, left)
+		})
+	}
+}
@@ -0,0 +1,81 @@
+package statistics
+
+import (
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// Mode is a [iterator.Reducer], which collects the most frequent values of a
+// stream.
+func Mode[T comparable](acc *ModeAccumulator[T], in T) {
+	if acc.counts == nil {
+		acc.counts = make(map[T]int)
+	}
+
+	if acc.counts[in] == 0 {
+		acc.order = append(acc.order, in)
+	}
+
+	acc.counts[in]++
+	acc.maxCount = max(acc.maxCount, acc.counts[in])
+}
+
+// ModeAccumulator is the accumulator type for the [Mode] reducer. It stores
+// each distinct value once together with its frequency.
+//
+// The zero value is an empty accumulator ready to use.
+type ModeAccumulator[T comparable] struct {
+	counts   map[T]int
+	order    []T
+	maxCount int
+}
+
+// Mode returns the most frequent value. If several values are equally
+// frequent, the one gathered first is returned.
+//
+// Returns an empty optional if no values were gathered.
+func (m ModeAccumulator[T]) Mode() optional.Optional[T] {
+	for _, v := range m.order {
+		if m.counts[v] == m.maxCount {
+			return optional.Of(v)
+		}
+	}
+
+	return optional.Empty[T]()
+}
+
+// Modes returns all values sharing the highest frequency, in the order they
+// were first gathered. It returns nil if no values were gathered.
+func (m ModeAccumulator[T]) Modes() []T {
+	var modes []T
+
+	for _, v := range m.order {
+		if m.counts[v] == m.maxCount {
+			modes = append(modes, v)
+		}
+	}
+
+	return modes
+}
+
+// Frequency returns how often the mode occurred, 0 if no values were
+// gathered.
+func (m ModeAccumulator[T]) Frequency() int {
+	return m.maxCount
+}
+
+// Merge combines other into m, as if the values gathered by other were
+// gathered after the ones of m.
+func (m *ModeAccumulator[T]) Merge(other *ModeAccumulator[T]) {
+	if m.counts == nil {
+		m.counts = make(map[T]int, len(other.counts))
+	}
+
+	for _, v := range other.order {
+		if m.counts[v] == 0 {
+			m.order = append(m.order, v)
+		}
+
+		m.counts[v] += other.counts[v]
+		m.maxCount = max(m.maxCount, m.counts[v])
+	}
+}
@@ -0,0 +1,114 @@
+package statistics_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleMode() {
+	i := iterator.Of(3, 1, 4, 1, 5, 9, 2, 6, 5, 3, 5)
+
+	acc := statistics.ModeAccumulator[int]{}
+	iterator.Reduce(i, &acc, statistics.Mode[int])
+
+	fmt.Println(acc.Mode(), acc.Frequency())
+
+	// Output: (Optional[int]: 5) 3
+}
+
+func ExampleModeAccumulator_Modes() {
+	i := iterator.Of("b", "a", "c", "a", "b")
+
+	acc := statistics.ModeAccumulator[string]{}
+	iterator.Reduce(i, &acc, statistics.Mode[string])
+
+	fmt.Println(acc.Modes())
+
+	// Output: [b a]
+}
+
+func TestMode(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name            string
+		input           []float64
+		expectMode      optional.Optional[float64]
+		expectModes     []float64
+		expectFrequency int
+	}
+
+	tests := []test{
+		{"empty", nil, optional.Empty[float64](), nil, 0},
+		{"single", []float64{1.5}, optional.Of(1.5), []float64{1.5}, 1},
+		{"unimodal", []float64{1, 2, 2, 3}, optional.Of(2.0), []float64{2}, 2},
+		{"bimodal", []float64{3, 1, 3, 1, 2}, optional.Of(3.0), []float64{3, 1}, 2},
+		{"all distinct", []float64{3, 2, 1}, optional.Of(3.0), []float64{3, 2, 1}, 1},
+		{"later value overtakes", []float64{1, 1, 2, 2, 2}, optional.Of(2.0), []float64{2}, 3},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			acc := statistics.ModeAccumulator[float64]{}
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &acc, statistics.Mode[float64])
+
+			// Assert
+			assert.Equal(t, tc.expectMode, acc.Mode())
+			assert.Equal(t, tc.expectModes, acc.Modes())
+			assert.Equal(t, tc.expectFrequency, acc.Frequency())
+		})
+	}
+}
+
+func TestModeAccumulator_Merge(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name  string
+		left  []int
+		right []int
+	}
+
+	tests := []test{
+		{"both empty", nil, nil},
+		{"left empty", nil, []int{1, 2, 2}},
+		{"right empty", []int{1, 2, 2}, nil},
+		{"mode only after merge", []int{1, 2, 2, 3}, []int{3, 3, 1}},
+		{"new values", []int{1, 1}, []int{5, 6, 6, 6}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			left := statistics.ModeAccumulator[int]{}
+			iterator.Reduce(iterator.Of(tc.left...), &left, statistics.Mode[int])
+
+			right := statistics.ModeAccumulator[int]{}
+			iterator.Reduce(iterator.Of(tc.right...), &right, statistics.Mode[int])
+
+			expect := statistics.ModeAccumulator[int]{}
+			iterator.Reduce(iterator.Concat(iterator.Of(tc.left...), iterator.Of(tc.right...)), &expect, statistics.Mode[int])
+
+			// Act
+			left.Merge(&right)
+
+			// Assert
+			assert.Equal(t, expect.Mode(), left.Mode())
+			assert.Equal(t, expect.Modes(), left.Modes())
+			assert.Equal(t, expect.Frequency(), left.Frequency())
+		})
+	}
+}
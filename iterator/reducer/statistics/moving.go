@@ -0,0 +1,379 @@
+package statistics
+
+import (
+	"cmp"
+	"iter"
+	"math"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/iterator"
+)
+
+// SimpleMovingAverage is a [iterator.Reducer], which collects the arithmetic
+// mean of the last values of a stream, as defined by the window size of the
+// accumulator.
+//
+// Panics if acc was not created by [NewMovingAverageAccumulator].
+func SimpleMovingAverage[T constraints.RealNumber](acc *MovingAverageAccumulator[T], in T) {
+	if cap(acc.window) == 0 {
+		panic("MovingAverageAccumulator must be created by NewMovingAverageAccumulator")
+	}
+
+	if len(acc.window) < cap(acc.window) {
+		acc.window = append(acc.window, in)
+		acc.sum += float64(in)
+
+		return
+	}
+
+	acc.sum += float64(in) - float64(acc.window[acc.next])
+	acc.window[acc.next] = in
+	acc.next = (acc.next + 1) % len(acc.window)
+
+	// Recomputing the sum once per cycle, prevents floating point errors from
+	// adding up over time.
+	if acc.next == 0 {
+		acc.sum = 0
+		for _, v := range acc.window {
+			acc.sum += float64(v)
+		}
+	}
+}
+
+// SimpleMovingAverageSeq creates an [iter.Seq] which yields the simple moving
+// average of each window of windowSize consecutive values, as created by
+// [iterator.SlidingWindow].
+//
+// If there are less than windowSize values, the average of all of them is
+// yielded once. Nothing is yielded if there are no values or windowSize is not
+// positive.
+func SimpleMovingAverageSeq[T constraints.RealNumber](values iter.Seq[T], windowSize int) iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		var (
+			sum    float64
+			oldest T
+		)
+
+		steps := 0
+
+		for window := range iterator.SlidingWindow(values, windowSize) {
+			if len(window) == 0 {
+				return
+			}
+
+			// Recomputing the sum once per window size, prevents floating point
+			// errors from adding up over time.
+			if steps%windowSize == 0 {
+				sum = 0
+				for _, v := range window {
+					sum += float64(v)
+				}
+			} else {
+				sum += float64(window[len(window)-1]) - float64(oldest)
+			}
+
+			oldest = window[0]
+			steps++
+
+			if !yield(sum / float64(len(window))) {
+				return
+			}
+		}
+	}
+}
+
+// MovingAverageAccumulator is the accumulator type for the
+// [SimpleMovingAverage] reducer.
+//
+// As a reducer receives single values instead of a sequence, the window is
+// kept in a ring buffer. [SimpleMovingAverageSeq] uses [iterator.SlidingWindow]
+// instead.
+//
+// The zero value is not usable, it must be created by
+// [NewMovingAverageAccumulator].
+type MovingAverageAccumulator[T constraints.RealNumber] struct {
+	// window is used as ring buffer, next is the index of the oldest value,
+	// which will be replaced next.
+	window []T
+	next   int
+	sum    float64
+}
+
+// NewMovingAverageAccumulator creates a new [MovingAverageAccumulator] for the
+// given window size.
+//
+// Panics if windowSize is not positive.
+func NewMovingAverageAccumulator[T constraints.RealNumber](windowSize int) MovingAverageAccumulator[T] {
+	if windowSize <= 0 {
+		panic("windowSize must be positive")
+	}
+
+	return MovingAverageAccumulator[T]{window: make([]T, 0, windowSize)}
+}
+
+// Mean returns the arithmetic mean of the values in the current window, or NaN
+// if no values were gathered yet.
+func (m MovingAverageAccumulator[T]) Mean() float64 {
+	return m.sum / float64(len(m.window))
+}
+
+// ExponentialMovingAverage is a [iterator.Reducer], which collects the
+// exponentially weighted moving average and variance of a stream.
+//
+// Panics if acc was not created by [NewEWMAAccumulator].
+func ExponentialMovingAverage[T constraints.RealNumber](acc *EWMAAccumulator, in T) {
+	if acc.alpha == 0 {
+		panic("EWMAAccumulator must be created by NewEWMAAccumulator")
+	}
+
+	v := float64(in)
+
+	if !acc.initialized {
+		acc.mean = v
+		acc.initialized = true
+
+		return
+	}
+
+	diff := v - acc.mean
+	increment := acc.alpha * diff
+
+	acc.mean += increment
+	acc.variance = (1 - acc.alpha) * (acc.variance + diff*increment)
+}
+
+// ExponentialMovingAverageSeq creates an [iter.Seq] which yields the
+// exponentially weighted moving average for each value of the input.
+func ExponentialMovingAverageSeq[T constraints.RealNumber](values iter.Seq[T], alpha float64) iter.Seq[float64] {
+	return exponentialMovingSeq(values, alpha, EWMAAccumulator.Mean)
+}
+
+// ExponentialMovingVarianceSeq creates an [iter.Seq] which yields the
+// exponentially weighted moving variance for each value of the input.
+func ExponentialMovingVarianceSeq[T constraints.RealNumber](values iter.Seq[T], alpha float64) iter.Seq[float64] {
+	return exponentialMovingSeq(values, alpha, EWMAAccumulator.Variance)
+}
+
+func exponentialMovingSeq[T constraints.RealNumber](
+	values iter.Seq[T],
+	alpha float64,
+	result func(EWMAAccumulator) float64,
+) iter.Seq[float64] {
+	return func(yield func(float64) bool) {
+		acc := NewEWMAAccumulator(alpha)
+
+		for v := range values {
+			ExponentialMovingAverage(&acc, v)
+
+			if !yield(result(acc)) {
+				return
+			}
+		}
+	}
+}
+
+// EWMAAccumulator is the accumulator type for the [ExponentialMovingAverage]
+// reducer.
+//
+// The zero value is not usable, it must be created by [NewEWMAAccumulator].
+type EWMAAccumulator struct {
+	alpha       float64
+	mean        float64
+	variance    float64
+	initialized bool
+}
+
+// NewEWMAAccumulator creates a new [EWMAAccumulator] with the given smoothing
+// factor alpha. The higher alpha, the more weight have recent values.
+//
+// Panics if alpha is not within (0, 1].
+func NewEWMAAccumulator(alpha float64) EWMAAccumulator {
+	if !(alpha > 0 && alpha <= 1) {
+		panic("alpha must be within (0, 1]")
+	}
+
+	return EWMAAccumulator{alpha: alpha}
+}
+
+// Mean returns the exponentially weighted moving average, or NaN if no values
+// were gathered yet.
+func (e EWMAAccumulator) Mean() float64 {
+	if !e.initialized {
+		return math.NaN()
+	}
+
+	return e.mean
+}
+
+// Variance returns the exponentially weighted moving variance, or NaN if no
+// values were gathered yet.
+func (e EWMAAccumulator) Variance() float64 {
+	if !e.initialized {
+		return math.NaN()
+	}
+
+	return e.variance
+}
+
+// StdDev returns the exponentially weighted moving standard deviation, or NaN
+// if no values were gathered yet.
+func (e EWMAAccumulator) StdDev() float64 {
+	return math.Sqrt(e.Variance())
+}
+
+// MovingMinMax is a [iterator.Reducer], which collects the minimum and maximum
+// of the last values of a stream, as defined by the window size of the
+// accumulator.
+//
+// It uses monotonic deques, so each step takes amortized constant time,
+// independent of the window size.
+//
+// Panics if acc was not created by [NewMovingMinMaxAccumulator].
+func MovingMinMax[T cmp.Ordered](acc *MovingMinMaxAccumulator[T], in T) {
+	if acc.windowSize == 0 {
+		panic("MovingMinMaxAccumulator must be created by NewMovingMinMaxAccumulator")
+	}
+
+	oldest := acc.index - acc.windowSize
+
+	acc.minDeque = pushMonotonic(acc.minDeque, indexed[T]{acc.index, in}, oldest,
+		func(last, in T) bool { return last >= in })
+	acc.maxDeque = pushMonotonic(acc.maxDeque, indexed[T]{acc.index, in}, oldest,
+		func(last, in T) bool { return last <= in })
+
+	acc.index++
+}
+
+// MovingMinSeq creates an [iter.Seq] which yields the minimum of each window
+// of windowSize consecutive values, as created by [iterator.SlidingWindow].
+//
+// If there are less than windowSize values, the minimum of all of them is
+// yielded once. Nothing is yielded if there are no values or windowSize is not
+// positive.
+func MovingMinSeq[T cmp.Ordered](values iter.Seq[T], windowSize int) iter.Seq[T] {
+	return movingMinMaxSeq(values, windowSize, MovingMinMaxAccumulator[T].Min)
+}
+
+// MovingMaxSeq creates an [iter.Seq] which yields the maximum of each window
+// of windowSize consecutive values, as created by [iterator.SlidingWindow].
+//
+// If there are less than windowSize values, the maximum of all of them is
+// yielded once. Nothing is yielded if there are no values or windowSize is not
+// positive.
+func MovingMaxSeq[T cmp.Ordered](values iter.Seq[T], windowSize int) iter.Seq[T] {
+	return movingMinMaxSeq(values, windowSize, MovingMinMaxAccumulator[T].Max)
+}
+
+// movingMinMaxSeq feeds the windows of [iterator.SlidingWindow] into a
+// [MovingMinMaxAccumulator]. As consecutive windows only differ by one value,
+// only the newest value of each window but the first needs to be added.
+func movingMinMaxSeq[T cmp.Ordered](
+	values iter.Seq[T],
+	windowSize int,
+	result func(MovingMinMaxAccumulator[T]) T,
+) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if windowSize <= 0 {
+			return
+		}
+
+		acc := NewMovingMinMaxAccumulator[T](windowSize)
+		first := true
+
+		for window := range iterator.SlidingWindow(values, windowSize) {
+			if len(window) == 0 {
+				return
+			}
+
+			if first {
+				for _, v := range window {
+					MovingMinMax(&acc, v)
+				}
+
+				first = false
+			} else {
+				MovingMinMax(&acc, window[len(window)-1])
+			}
+
+			if !yield(result(acc)) {
+				return
+			}
+		}
+	}
+}
+
+// MovingMinMaxAccumulator is the accumulator type for the [MovingMinMax]
+// reducer.
+//
+// The zero value is not usable, it must be created by
+// [NewMovingMinMaxAccumulator].
+type MovingMinMaxAccumulator[T cmp.Ordered] struct {
+	windowSize int
+	index      int
+
+	// The deques hold the candidates for min/max of the current window in
+	// order of their arrival, their values are monotonic, so the front always
+	// holds the extreme value.
+	minDeque []indexed[T]
+	maxDeque []indexed[T]
+}
+
+type indexed[T any] struct {
+	index int
+	value T
+}
+
+// NewMovingMinMaxAccumulator creates a new [MovingMinMaxAccumulator] for the
+// given window size.
+//
+// Panics if windowSize is not positive.
+func NewMovingMinMaxAccumulator[T cmp.Ordered](windowSize int) MovingMinMaxAccumulator[T] {
+	if windowSize <= 0 {
+		panic("windowSize must be positive")
+	}
+
+	return MovingMinMaxAccumulator[T]{windowSize: windowSize}
+}
+
+// Min returns the minimum of the current window, or the zero value if no
+// values were gathered yet.
+func (m MovingMinMaxAccumulator[T]) Min() T {
+	return front(m.minDeque)
+}
+
+// Max returns the maximum of the current window, or the zero value if no
+// values were gathered yet.
+func (m MovingMinMaxAccumulator[T]) Max() T {
+	return front(m.maxDeque)
+}
+
+// pushMonotonic appends in to the deque after removing all values from the
+// back which are dominated by in, and all values from the front which left the
+// window.
+func pushMonotonic[T any](
+	deque []indexed[T],
+	in indexed[T],
+	oldest int,
+	dominated func(last, in T) bool,
+) []indexed[T] {
+	for len(deque) > 0 && dominated(deque[len(deque)-1].value, in.value) {
+		deque = deque[:len(deque)-1]
+	}
+
+	deque = append(deque, in)
+
+	for deque[0].index <= oldest {
+		deque = deque[1:]
+	}
+
+	return deque
+}
+
+func front[T any](deque []indexed[T]) T {
+	if len(deque) == 0 {
+		var zero T
+		return zero
+	}
+
+	return deque[0].value
+}
@@ -0,0 +1,277 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleSimpleMovingAverageSeq() {
+	i := iterator.Of(2, 4, 6, 8, 10, 12)
+
+	for avg := range statistics.SimpleMovingAverageSeq(i, 3) {
+		fmt.Println(avg)
+	}
+
+	// Output:
+	// 4
+	// 6
+	// 8
+	// 10
+}
+
+func ExampleExponentialMovingAverage() {
+	i := iterator.Of(10, 20, 20, 20)
+	acc := statistics.NewEWMAAccumulator(0.5)
+
+	iterator.Reduce(i, &acc, statistics.ExponentialMovingAverage[int])
+
+	fmt.Printf("Mean: %.3f, Variance: %.3f\n", acc.Mean(), acc.Variance())
+
+	// Output:
+	// Mean: 18.750, Variance: 10.938
+}
+
+func ExampleMovingMinSeq() {
+	i := iterator.Of(5, 3, 4, 8, 7, 1, 6)
+
+	fmt.Println(slices.Collect(statistics.MovingMinSeq(i, 3)))
+	fmt.Println(slices.Collect(statistics.MovingMaxSeq(i, 3)))
+
+	// Output:
+	// [3 3 4 1 1]
+	// [5 8 8 8 7]
+}
+
+func TestSimpleMovingAverage(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name       string
+		input      []float64
+		windowSize int
+		expect     float64
+	}
+
+	tests := []test{
+		{"Should return NaN if input is empty", nil, 3, math.NaN()},
+		{"Should return mean of all values if window is not full", []float64{1, 2}, 3, 1.5},
+		{"Should return mean of the last values", []float64{1, 2, 3, 4, 5}, 3, 4},
+		{"Should return last value for window size 1", []float64{1, 2, 3, 4, 5}, 1, 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			acc := statistics.NewMovingAverageAccumulator[float64](tc.windowSize)
+
+			// Act
+			iterator.Reduce(iterator.Of(tc.input...), &acc, statistics.SimpleMovingAverage[float64])
+
+			// Assert
+			if math.IsNaN(tc.expect) {
+				assert.True(t, math.IsNaN(acc.Mean()))
+				return
+			}
+
+			assert.InDelta(t, tc.expect, acc.Mean(), 1e-9)
+		})
+	}
+}
+
+func TestSimpleMovingAverageSeq_matchesSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(1))
+
+	values := make([]float64, 10_000)
+	for i := range values {
+		values[i] = rand.Float64() * 1000
+	}
+
+	const windowSize = 7
+
+	// Act
+	got := slices.Collect(statistics.SimpleMovingAverageSeq(iterator.Of(values...), windowSize))
+
+	// Assert
+	assert.Len(t, got, len(values)-windowSize+1)
+
+	i := 0
+	for window := range iterator.SlidingWindow(iterator.Of(values...), windowSize) {
+		acc := statistics.MeanAccumulator[float64]{}
+		iterator.Reduce(iterator.Of(window...), &acc, statistics.Mean[float64])
+
+		assert.InDelta(t, acc.Mean(), got[i], 1e-9)
+
+		i++
+	}
+}
+
+func TestMovingSeq_shortInput(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name       string
+		input      []int
+		windowSize int
+		expectAvg  []float64
+		expectMin  []int
+		expectMax  []int
+	}
+
+	tests := []test{
+		{"Should yield nothing for empty input", nil, 3, nil, nil, nil},
+		{"Should yield nothing for window size 0", []int{1, 2}, 0, nil, nil, nil},
+		{"Should yield once if window is not full", []int{4, 1}, 3, []float64{2.5}, []int{1}, []int{4}},
+		{"Should yield once if window is exactly full", []int{4, 1, 7}, 3, []float64{4}, []int{1}, []int{7}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			avg := slices.Collect(statistics.SimpleMovingAverageSeq(iterator.Of(tc.input...), tc.windowSize))
+			mins := slices.Collect(statistics.MovingMinSeq(iterator.Of(tc.input...), tc.windowSize))
+			maxs := slices.Collect(statistics.MovingMaxSeq(iterator.Of(tc.input...), tc.windowSize))
+
+			// Assert
+			assert.Equal(t, tc.expectAvg, avg)
+			assert.Equal(t, tc.expectMin, mins)
+			assert.Equal(t, tc.expectMax, maxs)
+		})
+	}
+}
+
+func TestMovingSeq_stopEarly(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	values := iterator.Of(1, 2, 3, 4, 5, 6)
+
+	// Act & Assert
+	for range statistics.SimpleMovingAverageSeq(values, 2) {
+		break
+	}
+
+	for range statistics.MovingMinSeq(values, 2) {
+		break
+	}
+
+	for range statistics.ExponentialMovingAverageSeq(values, 0.5) {
+		break
+	}
+}
+
+func TestExponentialMovingAverageSeq(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	means := slices.Collect(statistics.ExponentialMovingAverageSeq(iterator.Of(1, 3, 3), 0.5))
+	variances := slices.Collect(statistics.ExponentialMovingVarianceSeq(iterator.Of(1, 3, 3), 0.5))
+
+	// Assert
+	assert.InDeltaSlice(t, []float64{1, 2, 2.5}, means, 1e-9)
+	assert.InDeltaSlice(t, []float64{0, 1, 0.75}, variances, 1e-9)
+}
+
+func TestEWMAAccumulator_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewEWMAAccumulator(0.1)
+
+	// Assert
+	assert.True(t, math.IsNaN(acc.Mean()))
+	assert.True(t, math.IsNaN(acc.Variance()))
+	assert.True(t, math.IsNaN(acc.StdDev()))
+}
+
+func TestNewEWMAAccumulator_invalidAlpha(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { statistics.NewEWMAAccumulator(0) })
+	assert.Panics(t, func() { statistics.NewEWMAAccumulator(1.1) })
+	assert.Panics(t, func() { statistics.NewEWMAAccumulator(math.NaN()) })
+	assert.NotPanics(t, func() { statistics.NewEWMAAccumulator(1) })
+}
+
+func TestMovingMinMax_matchesSlidingWindow(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(2))
+
+	values := make([]int, 1_000)
+	for i := range values {
+		values[i] = rand.Intn(100)
+	}
+
+	for _, windowSize := range []int{1, 2, 5, 50} {
+		t.Run(fmt.Sprintf("windowSize=%d", windowSize), func(t *testing.T) {
+			// Act
+			mins := slices.Collect(statistics.MovingMinSeq(iterator.Of(values...), windowSize))
+			maxs := slices.Collect(statistics.MovingMaxSeq(iterator.Of(values...), windowSize))
+
+			// Assert
+			assert.Len(t, mins, len(values)-windowSize+1)
+			assert.Len(t, maxs, len(values)-windowSize+1)
+
+			i := 0
+			for window := range iterator.SlidingWindow(iterator.Of(values...), windowSize) {
+				assert.Equal(t, slices.Min(window), mins[i])
+				assert.Equal(t, slices.Max(window), maxs[i])
+
+				i++
+			}
+		})
+	}
+}
+
+func TestMovingMinMax_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.NewMovingMinMaxAccumulator[string](3)
+
+	// Assert
+	assert.Empty(t, acc.Min())
+	assert.Empty(t, acc.Max())
+	assert.Panics(t, func() { statistics.NewMovingMinMaxAccumulator[string](0) })
+	assert.Panics(t, func() { statistics.NewMovingAverageAccumulator[int](0) })
+}
+
+func TestMovingAccumulators_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var (
+		average statistics.MovingAverageAccumulator[int]
+		ewma    statistics.EWMAAccumulator
+		minMax  statistics.MovingMinMaxAccumulator[int]
+	)
+
+	// Act & Assert
+	assert.True(t, math.IsNaN(average.Mean()))
+	assert.True(t, math.IsNaN(ewma.Mean()))
+	assert.Zero(t, minMax.Min())
+	assert.Zero(t, minMax.Max())
+
+	assert.PanicsWithValue(t, "MovingAverageAccumulator must be created by NewMovingAverageAccumulator",
+		func() { statistics.SimpleMovingAverage(&average, 1) })
+	assert.PanicsWithValue(t, "EWMAAccumulator must be created by NewEWMAAccumulator",
+		func() { statistics.ExponentialMovingAverage(&ewma, 1) })
+	assert.PanicsWithValue(t, "MovingMinMaxAccumulator must be created by NewMovingMinMaxAccumulator",
+		func() { statistics.MovingMinMax(&minMax, 1) })
+}
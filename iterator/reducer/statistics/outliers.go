@@ -0,0 +1,226 @@
+package statistics
+
+import (
+	"iter"
+	"math"
+	"slices"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/iterator"
+)
+
+// madToModifiedZScore is the factor converting a deviation in units of the
+// median absolute deviation to the modified z-score by Iglewicz and Hoaglin.
+const madToModifiedZScore = 0.6745
+
+// ZScoreOutliers creates an [iter.Seq2] which yields each value of the input
+// together with whether it is an outlier, because its distance to the mean is
+// more than threshold standard deviations (a common threshold is 3).
+//
+// As mean and standard deviation are computed from all values, the input is
+// collected before the first value is yielded. For unbounded streams use
+// [MovingZScoreOutliers].
+func ZScoreOutliers[T constraints.RealNumber](values iter.Seq[T], threshold float64) iter.Seq2[T, bool] {
+	return globalOutliers(values, threshold, zScoreTest[T])
+}
+
+// IQROutliers creates an [iter.Seq2] which yields each value of the input
+// together with whether it is an outlier, because it is more than k
+// interquartile ranges below the first or above the third quartile (Tukey's
+// fences, commonly k is 1.5).
+//
+// As the quartiles are computed from all values, the input is collected before
+// the first value is yielded. For unbounded streams use [MovingIQROutliers].
+func IQROutliers[T constraints.RealNumber](values iter.Seq[T], k float64) iter.Seq2[T, bool] {
+	return globalOutliers(values, k, iqrTest[T])
+}
+
+// MADOutliers creates an [iter.Seq2] which yields each value of the input
+// together with whether it is an outlier, because its modified z-score
+// 0.6745·|x - median|/MAD exceeds threshold (commonly 3.5).
+//
+// As median and MAD are computed from all values, the input is collected
+// before the first value is yielded. For unbounded streams use
+// [MovingMADOutliers].
+func MADOutliers[T constraints.RealNumber](values iter.Seq[T], threshold float64) iter.Seq2[T, bool] {
+	return globalOutliers(values, threshold, madTest[T])
+}
+
+// MovingZScoreOutliers works like [ZScoreOutliers], but each value is
+// compared to mean and standard deviation of the windowSize values before it,
+// so the threshold adapts to changes of the stream.
+//
+// The first windowSize values are never flagged as outliers.
+func MovingZScoreOutliers[T constraints.RealNumber](values iter.Seq[T], windowSize int, threshold float64) iter.Seq2[T, bool] {
+	return movingOutliers(values, windowSize, threshold, zScoreTest[T])
+}
+
+// MovingIQROutliers works like [IQROutliers], but each value is compared to
+// the quartiles of the windowSize values before it, so the fences adapt to
+// changes of the stream.
+//
+// The first windowSize values are never flagged as outliers.
+func MovingIQROutliers[T constraints.RealNumber](values iter.Seq[T], windowSize int, k float64) iter.Seq2[T, bool] {
+	return movingOutliers(values, windowSize, k, iqrTest[T])
+}
+
+// MovingMADOutliers works like [MADOutliers], but each value is compared to
+// median and MAD of the windowSize values before it, so the threshold adapts
+// to changes of the stream.
+//
+// The first windowSize values are never flagged as outliers.
+func MovingMADOutliers[T constraints.RealNumber](values iter.Seq[T], windowSize int, threshold float64) iter.Seq2[T, bool] {
+	return movingOutliers(values, windowSize, threshold, madTest[T])
+}
+
+// WithoutOutliers creates an [iter.Seq] which yields only the values from
+// flagged, which are not flagged as outliers.
+func WithoutOutliers[T any](flagged iter.Seq2[T, bool]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, outlier := range flagged {
+			if outlier {
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// OnlyOutliers creates an [iter.Seq] which yields only the values from
+// flagged, which are flagged as outliers.
+func OnlyOutliers[T any](flagged iter.Seq2[T, bool]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v, outlier := range flagged {
+			if !outlier {
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// outlierTest creates a function, which checks if a value is an outlier
+// compared to the given reference values.
+type outlierTest[T constraints.RealNumber] func(reference []T, threshold float64) func(T) bool
+
+func globalOutliers[T constraints.RealNumber](values iter.Seq[T], threshold float64, test outlierTest[T]) iter.Seq2[T, bool] {
+	return func(yield func(T, bool) bool) {
+		all := slices.Collect(values)
+		if len(all) == 0 {
+			return
+		}
+
+		isOutlier := test(all, threshold)
+
+		for _, v := range all {
+			if !yield(v, isOutlier(v)) {
+				return
+			}
+		}
+	}
+}
+
+func movingOutliers[T constraints.RealNumber](
+	values iter.Seq[T],
+	windowSize int,
+	threshold float64,
+	test outlierTest[T],
+) iter.Seq2[T, bool] {
+	if windowSize <= 0 {
+		panic("windowSize must be positive")
+	}
+
+	return func(yield func(T, bool) bool) {
+		first := true
+
+		// Each window holds the reference values and the value to check as last.
+		for window := range iterator.SlidingWindow(values, windowSize+1) {
+			if len(window) <= windowSize {
+				// Not more values than windowSize, all are warm-up values.
+				yieldNoOutliers(yield, window)
+
+				return
+			}
+
+			reference, candidate := window[:windowSize], window[windowSize]
+
+			if first && !yieldNoOutliers(yield, reference) {
+				return
+			}
+
+			first = false
+
+			if !yield(candidate, test(reference, threshold)(candidate)) {
+				return
+			}
+		}
+	}
+}
+
+func yieldNoOutliers[T any](yield func(T, bool) bool, values []T) bool {
+	for _, v := range values {
+		if !yield(v, false) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func zScoreTest[T constraints.RealNumber](reference []T, threshold float64) func(T) bool {
+	acc := NewMeanAccumulator[T](overflowSafeAccumulation[T]())
+	iterator.Reduce(iterator.Of(reference...), &acc, Mean[T])
+
+	mean := acc.Mean()
+
+	sumSquares := 0.0
+	for _, v := range reference {
+		sumSquares += (float64(v) - mean) * (float64(v) - mean)
+	}
+
+	stdDev := math.Sqrt(sumSquares / float64(len(reference)))
+
+	return func(v T) bool {
+		return exceeds(math.Abs(float64(v)-mean), stdDev, threshold)
+	}
+}
+
+func iqrTest[T constraints.RealNumber](reference []T, k float64) func(T) bool {
+	acc := QuantilesAccumulator[T]{values: slices.Clone(reference)}
+
+	q1 := acc.Quantile(0.25, InterpolationLinear) //nolint:mnd
+	q3 := acc.Quantile(0.75, InterpolationLinear) //nolint:mnd
+
+	iqr := q3 - q1
+
+	return func(v T) bool {
+		return float64(v) < q1-k*iqr || float64(v) > q3+k*iqr
+	}
+}
+
+func madTest[T constraints.RealNumber](reference []T, threshold float64) func(T) bool {
+	acc := QuantilesAccumulator[T]{values: slices.Clone(reference)}
+
+	median := acc.Median()
+	mad := acc.MedianAbsoluteDeviation()
+
+	return func(v T) bool {
+		return exceeds(madToModifiedZScore*math.Abs(float64(v)-median), mad, threshold)
+	}
+}
+
+// exceeds reports whether deviation/scale > threshold. If scale is 0, every
+// deviation other than 0 exceeds it.
+func exceeds(deviation, scale, threshold float64) bool {
+	if scale == 0 {
+		return deviation > 0
+	}
+
+	return deviation/scale > threshold
+}
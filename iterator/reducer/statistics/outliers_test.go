@@ -0,0 +1,191 @@
+package statistics_test
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleMADOutliers() {
+	i := iterator.Of(1, 2, 3, 4, 100)
+
+	for v, outlier := range statistics.MADOutliers(i, 3.5) {
+		fmt.Println(v, outlier)
+	}
+
+	// Output:
+	// 1 false
+	// 2 false
+	// 3 false
+	// 4 false
+	// 100 true
+}
+
+func ExampleMovingMADOutliers() {
+	i := iterator.Of(10, 11, 10, 12, 11, 50, 11, 10)
+
+	flagged := statistics.MovingMADOutliers(i, 4, 3.5)
+
+	fmt.Println(slices.Collect(statistics.WithoutOutliers(flagged)))
+	fmt.Println(slices.Collect(statistics.OnlyOutliers(flagged)))
+
+	// Output:
+	// [10 11 10 12 11 11 10]
+	// [50]
+}
+
+func TestOutliers(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		detect func(iter.Seq[int]) iter.Seq2[int, bool]
+		values []int
+		expect []int
+	}
+
+	zScore := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.ZScoreOutliers(values, 1.5) }
+	iqr := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.IQROutliers(values, 1.5) }
+	mad := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.MADOutliers(values, 3.5) }
+
+	tests := []test{
+		{"z-score", zScore, []int{1, 2, 3, 4, 100}, []int{100}},
+		{"z-score low", zScore, []int{-100, 1, 2, 3, 4}, []int{-100}},
+		{"z-score none", zScore, []int{1, 2, 3, 4, 5}, nil},
+		{"z-score constant", zScore, []int{3, 3, 3}, nil},
+		{"z-score overflowing sum", zScore, []int{4e18, 4.001e18, 3.999e18, 4e18, 5e18}, []int{5e18}},
+		{"iqr", iqr, []int{1, 2, 3, 4, 100}, []int{100}},
+		{"iqr both sides", iqr, []int{-20, 1, 2, 3, 4, 5, 6, 30}, []int{-20, 30}},
+		{"iqr none", iqr, []int{1, 2, 3, 4, 5}, nil},
+		{"mad", mad, []int{1, 2, 3, 4, 100}, []int{100}},
+		{"mad both sides", mad, []int{-20, 1, 2, 3, 4, 5, 6, 30}, []int{-20, 30}},
+		{"mad zero deviation", mad, []int{3, 3, 3, 3, 4}, []int{4}},
+		{"mad empty", mad, nil, nil},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			flagged := tc.detect(iterator.Of(tc.values...))
+
+			// Assert
+			assert.Equal(t, tc.expect, slices.Collect(statistics.OnlyOutliers(flagged)))
+			assert.Equal(t,
+				len(tc.values)-len(tc.expect),
+				len(slices.Collect(statistics.WithoutOutliers(flagged))))
+		})
+	}
+}
+
+func TestMovingOutliers(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		detect func(iter.Seq[int]) iter.Seq2[int, bool]
+		values []int
+		expect []bool
+	}
+
+	zScore := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.MovingZScoreOutliers(values, 3, 3) }
+	iqr := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.MovingIQROutliers(values, 3, 1.5) }
+	mad := func(values iter.Seq[int]) iter.Seq2[int, bool] { return statistics.MovingMADOutliers(values, 3, 3.5) }
+
+	tests := []test{
+		{"z-score", zScore, []int{1, 2, 3, 2, 50, 3}, []bool{false, false, false, false, true, false}},
+		{"iqr", iqr, []int{1, 2, 3, 2, 50, 3}, []bool{false, false, false, false, true, false}},
+		{"mad", mad, []int{1, 2, 3, 2, 50, 3}, []bool{false, false, false, false, true, false}},
+		{"warm-up is never flagged", mad, []int{1, 1000, 1}, []bool{false, false, false}},
+		{"shorter than window", mad, []int{1, 1000}, []bool{false, false}},
+		{"empty", mad, nil, []bool{}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			flags := make([]bool, 0, len(tc.values))
+			values := make([]int, 0, len(tc.values))
+
+			for v, outlier := range tc.detect(iterator.Of(tc.values...)) {
+				values = append(values, v)
+				flags = append(flags, outlier)
+			}
+
+			// Assert
+			assert.Equal(t, tc.expect, flags)
+			assert.Equal(t, len(tc.values), len(values))
+		})
+	}
+}
+
+func TestMovingOutliers_matchesGlobalOnWindow(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const windowSize = 20
+
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(7))
+
+	values := make([]float64, 500)
+	for i := range values {
+		values[i] = rand.NormFloat64()
+		if i%37 == 0 {
+			values[i] *= 10
+		}
+	}
+
+	// Act
+	moving := slices.Collect(iterator.PickRight(statistics.MovingIQROutliers(iterator.Of(values...), windowSize, 1.5)))
+
+	// Assert
+	for i := windowSize; i < len(values); i++ {
+		reference := statistics.QuantilesAccumulator[float64]{}
+		iterator.Reduce(iterator.Of(values[i-windowSize:i]...), &reference, statistics.Quantiles[float64])
+
+		q1 := reference.Quantile(0.25, statistics.InterpolationLinear)
+		q3 := reference.Quantile(0.75, statistics.InterpolationLinear)
+
+		expect := values[i] < q1-1.5*(q3-q1) || values[i] > q3+1.5*(q3-q1)
+		assert.Equal(t, expect, moving[i], "index %d", i)
+	}
+}
+
+func TestOutliers_stopEarly(t *testing.T) {
+	t.Parallel()
+
+	values := iterator.Of(1, 2, 3, 4, 100, 5, 6)
+
+	for name, flagged := range map[string]iter.Seq2[int, bool]{
+		"global": statistics.MADOutliers(values, 3.5),
+		"moving": statistics.MovingMADOutliers(values, 2, 3.5),
+	} {
+		count := 0
+
+		for range flagged {
+			count++
+			if count == 3 {
+				break
+			}
+		}
+
+		assert.Equal(t, 3, count, name)
+	}
+}
+
+func TestMovingOutliers_invalidWindowSize(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { statistics.MovingZScoreOutliers(iterator.Of(1), 0, 3) })
+}
@@ -0,0 +1,122 @@
+package statistics
+
+import (
+	"math"
+	"slices"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+)
+
+// Interpolation defines how a quantile is computed, when it lies between two
+// data points. The methods are named and behave like the ones of numpy's
+// quantile function.
+type Interpolation int
+
+const (
+	// InterpolationLinear interpolates linearly between the two surrounding
+	// data points.
+	InterpolationLinear Interpolation = iota
+	// InterpolationLower takes the lower of the two surrounding data points.
+	InterpolationLower
+	// InterpolationHigher takes the higher of the two surrounding data points.
+	InterpolationHigher
+	// InterpolationNearest takes the nearest of the two surrounding data
+	// points, ties are rounded to the even index.
+	InterpolationNearest
+	// InterpolationMidpoint takes the arithmetic mean of the two surrounding
+	// data points.
+	InterpolationMidpoint
+)
+
+// Quantiles is a [iterator.Reducer], which collects all values of a stream to
+// compute exact quantiles afterward.
+//
+// As all values are kept in memory, it is meant for bounded data. For large or
+// unbounded streams use [ApproxQuantiles].
+func Quantiles[T constraints.RealNumber](acc *QuantilesAccumulator[T], in T) {
+	acc.values = append(acc.values, in)
+	acc.sorted = false
+}
+
+// QuantilesAccumulator is the accumulator type for the [Quantiles] reducer.
+//
+// The zero value is an empty accumulator ready to use.
+type QuantilesAccumulator[T constraints.RealNumber] struct {
+	values []T
+	sorted bool
+}
+
+// Len returns the number of gathered values.
+func (q *QuantilesAccumulator[T]) Len() int {
+	return len(q.values)
+}
+
+// Merge adds all values gathered by other to q.
+func (q *QuantilesAccumulator[T]) Merge(other *QuantilesAccumulator[T]) {
+	q.values = append(q.values, other.values...)
+	q.sorted = false
+}
+
+// Quantile returns the p-quantile of the gathered values, using the given
+// interpolation method.
+//
+// It returns NaN if no values were gathered and panics if p is not within
+// [0, 1].
+func (q *QuantilesAccumulator[T]) Quantile(p float64, method Interpolation) float64 {
+	checkProbability(p)
+
+	if len(q.values) == 0 {
+		return math.NaN()
+	}
+
+	q.sort()
+
+	return quantileOfSorted(q.values, p, method)
+}
+
+// Median returns the median of the gathered values, which is the 0.5-quantile
+// with linear interpolation.
+func (q *QuantilesAccumulator[T]) Median() float64 {
+	return q.Quantile(0.5, InterpolationLinear)
+}
+
+func (q *QuantilesAccumulator[T]) sort() {
+	if q.sorted {
+		return
+	}
+
+	slices.Sort(q.values)
+	q.sorted = true
+}
+
+// quantileOfSorted computes the p-quantile of the given non-empty and sorted
+// values.
+func quantileOfSorted[T constraints.RealNumber](values []T, p float64, method Interpolation) float64 {
+	h := p * float64(len(values)-1)
+	lowIndex := int(math.Floor(h))
+	highIndex := int(math.Ceil(h))
+
+	low := float64(values[lowIndex])
+	high := float64(values[highIndex])
+
+	switch method {
+	case InterpolationLower:
+		return low
+	case InterpolationHigher:
+		return high
+	case InterpolationNearest:
+		return float64(values[int(math.RoundToEven(h))])
+	case InterpolationMidpoint:
+		return (low + high) / 2 //nolint:mnd
+	case InterpolationLinear:
+		return low + (h-float64(lowIndex))*(high-low)
+	default:
+		panic("unknown interpolation method")
+	}
+}
+
+func checkProbability(p float64) {
+	if p < 0 || p > 1 || math.IsNaN(p) {
+		panic("quantile probability must be within [0, 1]")
+	}
+}
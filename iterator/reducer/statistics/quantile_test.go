@@ -0,0 +1,119 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleQuantiles() {
+	i := iterator.Of(7, 1, 5, 3, 9, 2, 8, 4, 6, 10)
+	acc := statistics.QuantilesAccumulator[int]{}
+
+	iterator.Reduce(i, &acc, statistics.Quantiles[int])
+
+	fmt.Println("Median:", acc.Median())
+	fmt.Println("p90 linear:", acc.Quantile(0.9, statistics.InterpolationLinear))
+	fmt.Println("p95 lower:", acc.Quantile(0.95, statistics.InterpolationLower))
+	fmt.Println("p95 higher:", acc.Quantile(0.95, statistics.InterpolationHigher))
+
+	// Output:
+	// Median: 5.5
+	// p90 linear: 9.1
+	// p95 lower: 9
+	// p95 higher: 10
+}
+
+//nolint:funlen
+func TestQuantilesAccumulator_Quantile(t *testing.T) {
+	t.Parallel()
+
+	// Values and expectations as computed by numpy.quantile
+	values := []int{1, 2, 3, 4}
+
+	type test struct {
+		name   string
+		p      float64
+		method statistics.Interpolation
+		expect float64
+	}
+
+	tests := []test{
+		{"linear 0", 0, statistics.InterpolationLinear, 1},
+		{"linear 0.4", 0.4, statistics.InterpolationLinear, 2.2},
+		{"linear 0.5", 0.5, statistics.InterpolationLinear, 2.5},
+		{"linear 1", 1, statistics.InterpolationLinear, 4},
+		{"lower 0.4", 0.4, statistics.InterpolationLower, 2},
+		{"lower 0.5", 0.5, statistics.InterpolationLower, 2},
+		{"higher 0.4", 0.4, statistics.InterpolationHigher, 3},
+		{"higher 0.5", 0.5, statistics.InterpolationHigher, 3},
+		{"nearest 0.4", 0.4, statistics.InterpolationNearest, 2},
+		{"nearest 0.5 rounds to even index", 0.5, statistics.InterpolationNearest, 3},
+		{"nearest 0.9", 0.9, statistics.InterpolationNearest, 4},
+		{"midpoint 0.4", 0.4, statistics.InterpolationMidpoint, 2.5},
+		{"midpoint 1", 1, statistics.InterpolationMidpoint, 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Arrange
+			acc := statistics.QuantilesAccumulator[int]{}
+			iterator.Reduce(iterator.Of(values...), &acc, statistics.Quantiles[int])
+
+			// Act
+			got := acc.Quantile(tc.p, tc.method)
+
+			// Assert
+			assert.InDelta(t, tc.expect, got, 1e-9)
+		})
+	}
+}
+
+func TestQuantilesAccumulator_Quantile_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.QuantilesAccumulator[float64]{}
+
+	// Act
+	got := acc.Quantile(0.5, statistics.InterpolationLinear)
+
+	// Assert
+	assert.True(t, math.IsNaN(got))
+}
+
+func TestQuantilesAccumulator_Quantile_invalidProbability(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.QuantilesAccumulator[float64]{}
+
+	// Act & Assert
+	assert.Panics(t, func() { acc.Quantile(-0.1, statistics.InterpolationLinear) })
+	assert.Panics(t, func() { acc.Quantile(1.1, statistics.InterpolationLinear) })
+	assert.Panics(t, func() { acc.Quantile(math.NaN(), statistics.InterpolationLinear) })
+}
+
+func TestQuantilesAccumulator_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := statistics.QuantilesAccumulator[int]{}
+	b := statistics.QuantilesAccumulator[int]{}
+
+	iterator.Reduce(iterator.Of(1, 3, 5), &a, statistics.Quantiles[int])
+	iterator.Reduce(iterator.Of(2, 4), &b, statistics.Quantiles[int])
+
+	// Act
+	a.Merge(&b)
+
+	// Assert
+	assert.Equal(t, 5, a.Len())
+	assert.InDelta(t, 3.0, a.Median(), 1e-9)
+	assert.InDelta(t, 2.0, a.Quantile(0.25, statistics.InterpolationLinear), 1e-9)
+}
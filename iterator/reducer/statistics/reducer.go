@@ -20,22 +20,30 @@ func Max[T cmp.Ordered](acc *T, in T) {
 }
 
 // Mean is a [iterator.Reducer], which collects the mean value of a stream.
+//
+// By default, the values are summed up in T, use [NewMeanAccumulator] to
+// choose an accumulation, which is safe against overflows and precision loss.
 func Mean[T constraints.RealNumber](acc *MeanAccumulator[T], in T) {
-	acc.sum += in
 	acc.count++
+
+	switch acc.accumulation {
+	case AccumulateWide:
+		acc.addWide(in)
+	case AccumulateBig:
+		acc.addBig(in)
+	case AccumulateNative:
+		acc.sum += in
+	}
 }
 
 var _ iterator.Reducer[MinMaxAccumulator[int], int] = MinMax[int]
 
 // MinMax is a [iterator.Reducer], which collects the minimum and maximum value
-// of a stream.
-//
-// acc min must be initialized to the maximum value of the type,
-// acc max must be initialized to the minimum value of the type.
+// of a stream, see [MinMaxAccumulator].
 //
-// For real numbers, a correctly initialized MinMaxAccumulator can be created with
-// [NewMinMaxAccumulator].
+// Values are compared with [cmp.Compare], so for floats NaN is considered less
+// than any other value. If several values are equal to the extreme, the first
+// one is kept.
 func MinMax[T cmp.Ordered](acc *MinMaxAccumulator[T], in T) {
-	(*acc).setMin(min((*acc).Min(), in))
-	(*acc).setMax(max((*acc).Max(), in))
+	acc.add(in, cmp.Compare[T])
 }
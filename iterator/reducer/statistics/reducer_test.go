@@ -8,8 +8,10 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/KrischanCS/go-toolbox/constraints"
 	"github.com/KrischanCS/go-toolbox/iterator"
 	statistics2 "github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+	"github.com/KrischanCS/go-toolbox/optional"
 )
 
 func ExampleMin() {
@@ -48,11 +50,14 @@ func ExampleMean() {
 
 func ExampleMinMax() {
 	i := iterator.Of(-6.28, 2.78, 9.81, 1.41)
-	acc := statistics2.NewMinMaxAccumulator[float64]()
+	acc := statistics2.MinMaxAccumulator[float64]{}
 
 	iterator.Reduce(i, &acc, statistics2.MinMax[float64])
 
-	fmt.Printf("Min: %.2f, Max: %.2f\n", acc.Min(), acc.Max())
+	minimum, _ := acc.Min().Get()
+	maximum, _ := acc.Max().Get()
+
+	fmt.Printf("Min: %.2f, Max: %.2f\n", minimum, maximum)
 	// Output: Min: -6.28, Max: 9.81
 }
 
@@ -251,6 +256,90 @@ func TestMean(t *testing.T) {
 	}
 }
 
+func TestMean_accumulation(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int", func(t *testing.T) { testMeanAccumulation[int](t, math.MinInt, math.MaxInt, false) })
+	t.Run("int8", func(t *testing.T) { testMeanAccumulation[int8](t, math.MinInt8, math.MaxInt8, true) })
+	t.Run("int16", func(t *testing.T) { testMeanAccumulation[int16](t, math.MinInt16, math.MaxInt16, true) })
+	t.Run("int32", func(t *testing.T) { testMeanAccumulation[int32](t, math.MinInt32, math.MaxInt32, true) })
+	t.Run("int64", func(t *testing.T) { testMeanAccumulation[int64](t, math.MinInt64, math.MaxInt64, false) })
+	t.Run("uint", func(t *testing.T) { testMeanAccumulation[uint](t, 0, math.MaxUint, false) })
+	t.Run("uint8", func(t *testing.T) { testMeanAccumulation[uint8](t, 0, math.MaxUint8, true) })
+	t.Run("uint16", func(t *testing.T) { testMeanAccumulation[uint16](t, 0, math.MaxUint16, true) })
+	t.Run("uint32", func(t *testing.T) { testMeanAccumulation[uint32](t, 0, math.MaxUint32, true) })
+	t.Run("uint64", func(t *testing.T) { testMeanAccumulation[uint64](t, 0, math.MaxUint64, false) })
+	t.Run("uintptr", func(t *testing.T) { testMeanAccumulation[uintptr](t, 0, math.MaxUint, false) })
+	t.Run("float32", func(t *testing.T) { testMeanAccumulation[float32](t, -math.MaxFloat32, math.MaxFloat32, true) })
+	// There is no wider float type, so sums above MaxFloat64 are +Inf for all accumulations.
+	t.Run("float64", func(t *testing.T) { testMeanAccumulation[float64](t, -math.MaxFloat64/2, math.MaxFloat64/2, true) })
+}
+
+func testMeanAccumulation[T constraints.RealNumber](t *testing.T, minValue, maxValue T, wideFits bool) {
+	t.Helper()
+
+	for _, values := range [][]T{{maxValue, maxValue}, {minValue, minValue}, {maxValue, minValue, maxValue}} {
+		expect := float64(values[len(values)-1])
+		if len(values) == 3 { //nolint:mnd
+			expect = float64(minValue)/3 + 2*(float64(maxValue)/3) //nolint:mnd
+		}
+
+		accumulations := []statistics2.Accumulation{statistics2.AccumulateBig}
+		if wideFits {
+			accumulations = append(accumulations, statistics2.AccumulateWide)
+		}
+
+		for _, accumulation := range accumulations {
+			acc := statistics2.NewMeanAccumulator[T](accumulation)
+
+			iterator.Reduce(iterator.Of(values...), &acc, statistics2.Mean[T])
+
+			assert.InDelta(t, expect, acc.Mean(), math.Abs(expect)*1e-9, "%v: %v", accumulation, values)
+		}
+	}
+}
+
+func TestMean_accumulation_float(t *testing.T) {
+	t.Parallel()
+
+	type celsius float64
+
+	// Arrange
+	values := iterator.Of[celsius](1e100, 1, -1e100, 1)
+
+	native := statistics2.MeanAccumulator[celsius]{}
+	wide := statistics2.NewMeanAccumulator[celsius](statistics2.AccumulateWide)
+	big := statistics2.NewMeanAccumulator[celsius](statistics2.AccumulateBig)
+
+	// Act
+	iterator.Reduce(values, &native, statistics2.Mean[celsius])
+	iterator.Reduce(values, &wide, statistics2.Mean[celsius])
+	iterator.Reduce(values, &big, statistics2.Mean[celsius])
+
+	// Assert
+	assert.InDelta(t, 0.25, native.Mean(), 1e-9)
+	assert.InDelta(t, 0.5, wide.Mean(), 1e-9)
+	assert.InDelta(t, 0.5, big.Mean(), 1e-9)
+}
+
+func TestMean_accumulation_nativeOverflows(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	values := iterator.Of[int8](100, 100)
+
+	native := statistics2.MeanAccumulator[int8]{}
+	wide := statistics2.NewMeanAccumulator[int8](statistics2.AccumulateWide)
+
+	// Act
+	iterator.Reduce(values, &native, statistics2.Mean[int8])
+	iterator.Reduce(values, &wide, statistics2.Mean[int8])
+
+	// Assert
+	assert.InDelta(t, -28.0, native.Mean(), 1e-9)
+	assert.InDelta(t, 100.0, wide.Mean(), 1e-9)
+}
+
 //nolint:funlen
 func TestMinMax(t *testing.T) {
 	t.Parallel()
@@ -259,59 +348,59 @@ func TestMinMax(t *testing.T) {
 		name         string
 		input        iter.Seq[int]
 		initialValue statistics2.MinMaxAccumulator[int]
-		expectMin    int
-		expectMax    int
+		expectMin    optional.Optional[int]
+		expectMax    optional.Optional[int]
 	}
 
 	tests := []test{
 		{
-			name:         "Should not alter initial value if input is empty",
+			name:         "Should return empty optionals if input is empty",
 			input:        iterator.Of[int](),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    math.MaxInt,
-			expectMax:    math.MinInt,
+			expectMin:    optional.Empty[int](),
+			expectMax:    optional.Empty[int](),
 		},
 		{
 			name:         "Should return min and max as the given value if only one is given",
 			input:        iterator.Of[int](1),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    1,
-			expectMax:    1,
+			expectMin:    optional.Of(1),
+			expectMax:    optional.Of(1),
 		},
 		{
 			name:         "Should return min and max correctly for two values",
 			input:        iterator.Of[int](1, 2),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    1,
-			expectMax:    2,
+			expectMin:    optional.Of(1),
+			expectMax:    optional.Of(2),
 		},
 		{
 			name:         "Should return min and max correctly for multiple values",
 			input:        iterator.Of[int](1, 2, 3, 4, 5),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    1,
-			expectMax:    5,
+			expectMin:    optional.Of(1),
+			expectMax:    optional.Of(5),
 		},
 		{
 			name:         "Should return min and max correctly with negative values",
 			input:        iterator.Of[int](-1, -2, -3, -4, -5),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    -5,
-			expectMax:    -1,
+			expectMin:    optional.Of(-5),
+			expectMax:    optional.Of(-1),
 		},
 		{
 			name:         "Should return min and max correctly with mixed values",
 			input:        iterator.Of[int](-1, 2, -3, 4, -5),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    -5,
-			expectMax:    4,
+			expectMin:    optional.Of(-5),
+			expectMax:    optional.Of(4),
 		},
 		{
 			name:         "Should work correctly with large numbers",
 			input:        iterator.Of[int](math.MaxInt-1, math.MinInt+1),
 			initialValue: statistics2.NewMinMaxAccumulator[int](),
-			expectMin:    math.MinInt + 1,
-			expectMax:    math.MaxInt - 1,
+			expectMin:    optional.Of(math.MinInt + 1),
+			expectMax:    optional.Of(math.MaxInt - 1),
 		},
 	}
 
@@ -0,0 +1,86 @@
+package statistics
+
+import (
+	"math"
+)
+
+// MedianAbsoluteDeviation returns the median of the absolute deviations of the
+// gathered values from their median, which is a measure of dispersion robust
+// against outliers.
+//
+// The result is not scaled, to use it as consistent estimator of the standard
+// deviation of normally distributed data, multiply it by 1.4826.
+//
+// It returns NaN if no values were gathered.
+func (q *QuantilesAccumulator[T]) MedianAbsoluteDeviation() float64 {
+	if len(q.values) == 0 {
+		return math.NaN()
+	}
+
+	median := q.Median()
+
+	deviations := QuantilesAccumulator[float64]{values: make([]float64, len(q.values))}
+	for i, v := range q.values {
+		deviations.values[i] = math.Abs(float64(v) - median)
+	}
+
+	return deviations.Median()
+}
+
+// TrimmedMean returns the arithmetic mean of the gathered values, after
+// removing the given proportion of the lowest and of the highest values.
+//
+// It returns NaN if no values were gathered and panics if proportion is not
+// within [0, 0.5).
+func (q *QuantilesAccumulator[T]) TrimmedMean(proportion float64) float64 {
+	trimmed := q.trimCount(proportion)
+
+	if len(q.values) == 0 {
+		return math.NaN()
+	}
+
+	q.sort()
+
+	acc := NewMeanAccumulator[T](overflowSafeAccumulation[T]())
+	for _, v := range q.values[trimmed : len(q.values)-trimmed] {
+		Mean(&acc, v)
+	}
+
+	return acc.Mean()
+}
+
+// WinsorizedMean returns the arithmetic mean of the gathered values, after
+// replacing the given proportion of the lowest and of the highest values with
+// the lowest and highest remaining value.
+//
+// It returns NaN if no values were gathered and panics if proportion is not
+// within [0, 0.5).
+func (q *QuantilesAccumulator[T]) WinsorizedMean(proportion float64) float64 {
+	replaced := q.trimCount(proportion)
+
+	if len(q.values) == 0 {
+		return math.NaN()
+	}
+
+	q.sort()
+
+	lowest := q.values[replaced]
+	highest := q.values[len(q.values)-1-replaced]
+
+	acc := NewMeanAccumulator[T](overflowSafeAccumulation[T]())
+	for _, v := range q.values {
+		Mean(&acc, min(max(v, lowest), highest))
+	}
+
+	return acc.Mean()
+}
+
+// trimCount returns the number of values to trim on each side for the given
+// proportion.
+func (q *QuantilesAccumulator[T]) trimCount(proportion float64) int {
+	if !(proportion >= 0 && proportion < 0.5) {
+		panic("proportion must be within [0, 0.5)")
+	}
+
+	return int(float64(len(q.values)) * proportion)
+}
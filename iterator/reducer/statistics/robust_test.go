@@ -0,0 +1,104 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleQuantilesAccumulator_MedianAbsoluteDeviation() {
+	i := iterator.Of(1, 2, 3, 4, 100)
+	acc := statistics.QuantilesAccumulator[int]{}
+
+	iterator.Reduce(i, &acc, statistics.Quantiles[int])
+
+	fmt.Println("MAD:", acc.MedianAbsoluteDeviation())
+	fmt.Println("Trimmed mean:", acc.TrimmedMean(0.2))
+	fmt.Println("Winsorized mean:", acc.WinsorizedMean(0.2))
+
+	// Output:
+	// MAD: 1
+	// Trimmed mean: 3
+	// Winsorized mean: 3
+}
+
+func TestQuantilesAccumulator_robust(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name       string
+		values     []float64
+		proportion float64
+		mad        float64
+		trimmed    float64
+		winsorized float64
+	}
+
+	tests := []test{
+		{"single value", []float64{5}, 0.25, 0, 5, 5},
+		{"no trimming", []float64{1, 2, 3, 10}, 0, 1, 4, 4},
+		{"trim one of each side", []float64{10, -50, 1, 2, 3, 4, 5, 6}, 0.125, 2, 3.5, 3.5},
+		{"proportion rounded down", []float64{1, 2, 3, 4, 100}, 0.1, 1, 22, 22},
+		{"constant", []float64{3, 3, 3, 3}, 0.25, 0, 3, 3},
+		{"winsorized differs", []float64{1, 2, 4, 8, 16, 32, 64, 128}, 0.25, 10.5, 15, 16.5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			acc := statistics.QuantilesAccumulator[float64]{}
+			iterator.Reduce(iterator.Of(tc.values...), &acc, statistics.Quantiles[float64])
+
+			// Act
+			mad := acc.MedianAbsoluteDeviation()
+			trimmed := acc.TrimmedMean(tc.proportion)
+			winsorized := acc.WinsorizedMean(tc.proportion)
+
+			// Assert
+			assert.InDelta(t, tc.mad, mad, 1e-12)
+			assert.InDelta(t, tc.trimmed, trimmed, 1e-12)
+			assert.InDelta(t, tc.winsorized, winsorized, 1e-12)
+		})
+	}
+}
+
+func TestQuantilesAccumulator_robust_empty(t *testing.T) {
+	t.Parallel()
+
+	acc := statistics.QuantilesAccumulator[int]{}
+
+	assert.True(t, math.IsNaN(acc.MedianAbsoluteDeviation()))
+	assert.True(t, math.IsNaN(acc.TrimmedMean(0.1)))
+	assert.True(t, math.IsNaN(acc.WinsorizedMean(0.1)))
+}
+
+func TestQuantilesAccumulator_TrimmedMean_invalidProportion(t *testing.T) {
+	t.Parallel()
+
+	acc := statistics.QuantilesAccumulator[int]{}
+	statistics.Quantiles(&acc, 1)
+
+	for _, proportion := range []float64{-0.1, 0.5, 1, math.NaN()} {
+		assert.Panics(t, func() { acc.TrimmedMean(proportion) })
+		assert.Panics(t, func() { acc.WinsorizedMean(proportion) })
+	}
+}
+
+func TestQuantilesAccumulator_robust_overflowingSum(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	acc := statistics.QuantilesAccumulator[int64]{}
+	iterator.Reduce(iterator.Of[int64](math.MaxInt64, math.MaxInt64, math.MaxInt64), &acc, statistics.Quantiles[int64])
+
+	// Act & Assert
+	assert.InDelta(t, float64(math.MaxInt64), acc.TrimmedMean(0.25), 1e4)
+	assert.InDelta(t, float64(math.MaxInt64), acc.WinsorizedMean(0.25), 1e4)
+}
@@ -3,40 +3,143 @@
 package statistics
 
 import (
-	"fmt"
+	"math/big"
+	"unsafe"
 
 	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer"
 )
 
-// MinMaxAccumulator is the accumulator type for the [MinMax] reducer.
-type MinMaxAccumulator[T any] interface {
-	Min() T
-	Max() T
+// Accumulation defines how a [MeanAccumulator] sums up the gathered values.
+type Accumulation int
 
-	setMin(newMin T)
-	setMax(newMax T)
-}
-
-// NewMinMaxAccumulator creates a new [MinMaxAccumulator] with the correct
-// initial values.
-func NewMinMaxAccumulator[T constraints.RealNumber]() MinMaxAccumulator[T] {
-	minMaxer, ok := initMinMax[T]()
-	if !ok {
-		var t T
-
-		panic(fmt.Sprintf("Conversion failed for type %T", t))
-	}
-
-	return minMaxer
-}
+const (
+	// AccumulateNative sums in the type of the values itself. It is the
+	// fastest option, but integers silently wrap around on overflow and floats
+	// lose precision on long streams.
+	AccumulateNative Accumulation = iota
+	// AccumulateWide sums signed integers in an int64, unsigned integers in an
+	// uint64 and floats in a float64 with Neumaier compensation (see
+	// [reducer.NeumaierSum]).
+	//
+	// It prevents overflows only for integers of up to 32 bits. Sums of
+	// 64-bit integers (int64, uint64, int, uint) still silently wrap around, use
+	// AccumulateBig for them.
+	AccumulateWide
+	// AccumulateBig sums integers exactly in a [big.Int], so they can never
+	// overflow. Floats are summed like with AccumulateWide.
+	AccumulateBig
+)
 
 // MeanAccumulator is the accumulator type for the [Mean] reducer.
+//
+// The zero value uses [AccumulateNative], other accumulations can be chosen
+// with [NewMeanAccumulator].
 type MeanAccumulator[T constraints.RealNumber] struct {
 	sum   T
 	count int
+
+	accumulation Accumulation
+	kind         numberKind
+
+	signedSum   int64
+	unsignedSum uint64
+	floatSum    reducer.CompensatedSumAccumulator[float64]
+	bigSum      *big.Int
+	bigScratch  *big.Int
+}
+
+// NewMeanAccumulator creates a new [MeanAccumulator] which sums up the
+// gathered values as defined by accumulation.
+func NewMeanAccumulator[T constraints.RealNumber](accumulation Accumulation) MeanAccumulator[T] {
+	acc := MeanAccumulator[T]{
+		accumulation: accumulation,
+		kind:         kindOf[T](),
+	}
+
+	if accumulation == AccumulateBig {
+		acc.bigSum = new(big.Int)
+		acc.bigScratch = new(big.Int)
+	}
+
+	return acc
 }
 
 // Mean returns the arithmetic mean of the gathered values.
 func (m MeanAccumulator[T]) Mean() float64 {
-	return float64(m.sum) / float64(m.count)
+	switch {
+	case m.accumulation == AccumulateNative:
+		return float64(m.sum) / float64(m.count)
+	case m.kind == kindFloat:
+		return m.floatSum.Sum() / float64(m.count)
+	case m.accumulation == AccumulateBig:
+		sum := new(big.Float).SetInt(m.bigSum)
+		mean, _ := sum.Quo(sum, new(big.Float).SetInt64(int64(m.count))).Float64()
+
+		return mean
+	case m.kind == kindSigned:
+		return float64(m.signedSum) / float64(m.count)
+	default:
+		return float64(m.unsignedSum) / float64(m.count)
+	}
+}
+
+func (m *MeanAccumulator[T]) addWide(in T) {
+	switch m.kind {
+	case kindFloat:
+		reducer.NeumaierSum(&m.floatSum, float64(in))
+	case kindSigned:
+		m.signedSum += int64(in)
+	case kindUnsigned:
+		m.unsignedSum += uint64(in)
+	}
+}
+
+func (m *MeanAccumulator[T]) addBig(in T) {
+	switch m.kind {
+	case kindFloat:
+		reducer.NeumaierSum(&m.floatSum, float64(in))
+	case kindSigned:
+		m.bigSum.Add(m.bigSum, m.bigScratch.SetInt64(int64(in)))
+	case kindUnsigned:
+		m.bigSum.Add(m.bigSum, m.bigScratch.SetUint64(uint64(in)))
+	}
+}
+
+type numberKind int
+
+const (
+	kindSigned numberKind = iota
+	kindUnsigned
+	kindFloat
+)
+
+// overflowSafeAccumulation returns the fastest [Accumulation], which can't
+// overflow for values of type T: [AccumulateBig] for 64-bit integers,
+// otherwise [AccumulateWide].
+func overflowSafeAccumulation[T constraints.RealNumber]() Accumulation {
+	var zero T
+
+	if kindOf[T]() != kindFloat && unsafe.Sizeof(zero) >= 8 { //nolint:mnd
+		return AccumulateBig
+	}
+
+	return AccumulateWide
+}
+
+// kindOf determines the kind of T arithmetically, so it also works for named
+// types like 'type Celsius float64'.
+func kindOf[T constraints.RealNumber]() numberKind {
+	var zero T
+
+	one, two := zero+1, zero+2
+
+	switch {
+	case one/two != 0:
+		return kindFloat
+	case zero-1 < 0:
+		return kindSigned
+	default:
+		return kindUnsigned
+	}
 }
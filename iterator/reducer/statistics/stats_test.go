@@ -1,151 +1,48 @@
 package statistics
 
 import (
-	"math"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
-)
-
-func TestNewMinMax_int(t *testing.T) {
-	t.Parallel()
 
-	// Act
-	mm := NewMinMaxAccumulator[int]()
-
-	// Assert
-	assert.Equal(t, math.MaxInt, mm.Min())
-	assert.Equal(t, math.MinInt, mm.Max())
-}
-
-func TestNewMinMax_int8(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[int8]()
-
-	// Assert
-	assert.Equal(t, int8(math.MaxInt8), mm.Min())
-	assert.Equal(t, int8(math.MinInt8), mm.Max())
-}
-
-func TestNewMinMax_int16(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[int16]()
-
-	// Assert
-	assert.Equal(t, int16(math.MaxInt16), mm.Min())
-	assert.Equal(t, int16(math.MinInt16), mm.Max())
-}
-
-func TestNewMinMax_int32(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[int32]()
-
-	// Assert
-	assert.Equal(t, int32(math.MaxInt32), mm.Min())
-	assert.Equal(t, int32(math.MinInt32), mm.Max())
-}
-
-func TestNewMinMax_int64(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[int64]()
-
-	// Assert
-	assert.Equal(t, int64(math.MaxInt64), mm.Min())
-	assert.Equal(t, int64(math.MinInt64), mm.Max())
-}
-
-func TestNewMinMax_uint(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uint]()
-
-	// Assert
-	assert.Equal(t, uint(math.MaxUint), mm.Min())
-	assert.Equal(t, uint(0), mm.Max())
-}
-
-func TestNewMinMax_uint8(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uint8]()
-
-	// Assert
-	assert.Equal(t, uint8(math.MaxUint8), mm.Min())
-	assert.Equal(t, uint8(0), mm.Max())
-}
-
-func TestNewMinMax_uint16(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uint16]()
-
-	// Assert
-	assert.Equal(t, uint16(math.MaxUint16), mm.Min())
-	assert.Equal(t, uint16(0), mm.Max())
-}
-
-func TestNewMinMax_uint32(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uint32]()
-
-	// Assert
-	assert.Equal(t, uint32(math.MaxUint32), mm.Min())
-	assert.Equal(t, uint32(0), mm.Max())
-}
-
-func TestNewMinMax_uint64(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uint64]()
-
-	// Assert
-	assert.Equal(t, uint64(math.MaxUint64), mm.Min())
-	assert.Equal(t, uint64(0), mm.Max())
-}
-
-func TestNewMinMax_uintptr(t *testing.T) {
-	t.Parallel()
-
-	// Act
-	mm := NewMinMaxAccumulator[uintptr]()
+	"github.com/KrischanCS/go-toolbox/optional"
+)
 
-	// Assert
-	assert.Equal(t, uintptr(math.MaxUint), mm.Min())
-	assert.Equal(t, uintptr(0), mm.Max())
-}
+type celsius float64
 
-func TestNewMinMax_float32(t *testing.T) {
+func TestNewMinMax(t *testing.T) {
 	t.Parallel()
 
-	// Act
-	mm := NewMinMaxAccumulator[float32]()
-
-	// Assert
-	assert.InEpsilon(t, float32(math.MaxFloat32), mm.Min(), 0.0001)
-	assert.InEpsilon(t, float32(-math.MaxFloat32), mm.Max(), 0.0001)
+	t.Run("int", func(t *testing.T) { testNewMinMaxIsEmpty[int](t) })
+	t.Run("int8", func(t *testing.T) { testNewMinMaxIsEmpty[int8](t) })
+	t.Run("int16", func(t *testing.T) { testNewMinMaxIsEmpty[int16](t) })
+	t.Run("int32", func(t *testing.T) { testNewMinMaxIsEmpty[int32](t) })
+	t.Run("int64", func(t *testing.T) { testNewMinMaxIsEmpty[int64](t) })
+	t.Run("uint", func(t *testing.T) { testNewMinMaxIsEmpty[uint](t) })
+	t.Run("uint8", func(t *testing.T) { testNewMinMaxIsEmpty[uint8](t) })
+	t.Run("uint16", func(t *testing.T) { testNewMinMaxIsEmpty[uint16](t) })
+	t.Run("uint32", func(t *testing.T) { testNewMinMaxIsEmpty[uint32](t) })
+	t.Run("uint64", func(t *testing.T) { testNewMinMaxIsEmpty[uint64](t) })
+	t.Run("uintptr", func(t *testing.T) { testNewMinMaxIsEmpty[uintptr](t) })
+	t.Run("float32", func(t *testing.T) { testNewMinMaxIsEmpty[float32](t) })
+	t.Run("float64", func(t *testing.T) { testNewMinMaxIsEmpty[float64](t) })
+	t.Run("string", func(t *testing.T) { testNewMinMaxIsEmpty[string](t) })
+	t.Run("named float", func(t *testing.T) { testNewMinMaxIsEmpty[celsius](t) })
+	t.Run("time.Time", func(t *testing.T) { testNewMinMaxIsEmpty[time.Time](t) })
 }
 
-func TestNewMinMax_float64(t *testing.T) {
-	t.Parallel()
+func testNewMinMaxIsEmpty[T any](t *testing.T) {
+	t.Helper()
 
 	// Act
-	mm := NewMinMaxAccumulator[float64]()
+	mm := NewMinMaxAccumulator[T]()
 
 	// Assert
-	assert.InEpsilon(t, math.MaxFloat64, mm.Min(), 0.0001)
-	assert.InEpsilon(t, -math.MaxFloat64, mm.Max(), 0.0001)
+	assert.Equal(t, MinMaxAccumulator[T]{}, mm)
+	assert.Equal(t, 0, mm.Count())
+	assert.Equal(t, optional.Empty[T](), mm.Min())
+	assert.Equal(t, optional.Empty[T](), mm.Max())
+	assert.Equal(t, optional.Empty[int](), mm.ArgMin())
+	assert.Equal(t, optional.Empty[int](), mm.ArgMax())
 }
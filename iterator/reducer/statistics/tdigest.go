@@ -0,0 +1,212 @@
+package statistics
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+)
+
+// DefaultCompression is the compression used by a [TDigest], when none is
+// given.
+const DefaultCompression = 100
+
+// bufferFactor defines how many values are buffered relative to the
+// compression, before the buffer is merged into the centroids.
+const bufferFactor = 5
+
+// ApproxQuantiles is a [iterator.Reducer], which gathers the values of a stream
+// into a [TDigest] to estimate quantiles without keeping every value.
+func ApproxQuantiles[T constraints.RealNumber](acc *TDigest, in T) {
+	acc.Add(float64(in))
+}
+
+// TDigest is a sketch for estimating quantiles of a stream in bounded memory,
+// based on the merging t-digest by Ted Dunning.
+//
+// The number of kept centroids is bounded by roughly the compression, so
+// memory does not grow with the number of values. Centroids are small near the
+// tails and large near the median: for a compression δ, the error of the
+// estimated rank of a quantile q is approximately bounded by π·√(q·(1-q))/δ,
+// which is at most π/(2·δ) at the median and much smaller for extreme
+// quantiles like p99 or p99.9. The minimum and maximum are always exact.
+//
+// The zero value is an empty TDigest using [DefaultCompression].
+type TDigest struct {
+	compression float64
+
+	centroids []centroid
+	buffer    []centroid
+
+	count float64
+	min   float64
+	max   float64
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// NewTDigest creates a new empty [TDigest] with the given compression. Higher
+// compression results in more accurate estimates, but requires more memory.
+//
+// Panics if compression is not positive.
+func NewTDigest(compression float64) TDigest {
+	if compression <= 0 || math.IsNaN(compression) {
+		panic("compression must be positive")
+	}
+
+	return TDigest{compression: compression}
+}
+
+// Add adds a value to the digest. NaN values are ignored.
+func (t *TDigest) Add(value float64) {
+	t.addWeighted(value, 1)
+}
+
+// Count returns the number of values added to the digest.
+func (t *TDigest) Count() int {
+	return int(t.count)
+}
+
+// Merge adds all values summarized by other to t. other is not modified.
+func (t *TDigest) Merge(other *TDigest) {
+	// Copying first, so merging a digest into itself is safe.
+	for _, c := range slices.Concat(other.centroids, other.buffer) {
+		t.addWeighted(c.mean, c.weight)
+	}
+
+	if other.count > 0 {
+		t.min = min(t.min, other.min)
+		t.max = max(t.max, other.max)
+	}
+}
+
+// Quantile returns the estimated p-quantile of the added values.
+//
+// It returns NaN if no values were added and panics if p is not within [0, 1].
+func (t *TDigest) Quantile(p float64) float64 {
+	checkProbability(p)
+
+	if t.count == 0 {
+		return math.NaN()
+	}
+
+	t.compress()
+
+	return t.quantile(p * t.count)
+}
+
+// Median returns the estimated median of the added values.
+func (t *TDigest) Median() float64 {
+	return t.Quantile(0.5)
+}
+
+func (t *TDigest) quantile(index float64) float64 {
+	cs := t.centroids
+
+	first, last := cs[0], cs[len(cs)-1]
+
+	if index <= first.weight/2 {
+		return interpolate(t.min, first.mean, index/(first.weight/2))
+	}
+
+	weightSoFar := first.weight / 2
+
+	for i := range len(cs) - 1 {
+		dw := (cs[i].weight + cs[i+1].weight) / 2
+		if weightSoFar+dw > index {
+			return interpolate(cs[i].mean, cs[i+1].mean, (index-weightSoFar)/dw)
+		}
+
+		weightSoFar += dw
+	}
+
+	rest := t.count - weightSoFar
+	if rest <= 0 {
+		return t.max
+	}
+
+	return interpolate(last.mean, t.max, (index-weightSoFar)/rest)
+}
+
+func (t *TDigest) addWeighted(value, weight float64) {
+	if math.IsNaN(value) {
+		return
+	}
+
+	if t.count == 0 {
+		t.min, t.max = value, value
+	}
+
+	t.min = min(t.min, value)
+	t.max = max(t.max, value)
+	t.count += weight
+
+	t.buffer = append(t.buffer, centroid{mean: value, weight: weight})
+
+	if len(t.buffer) >= bufferFactor*int(t.getCompression()) {
+		t.compress()
+	}
+}
+
+// compress merges the buffer into the centroids, so that no centroid spans
+// more than one unit of the scale function.
+func (t *TDigest) compress() {
+	if len(t.buffer) == 0 {
+		return
+	}
+
+	all := slices.Concat(t.centroids, t.buffer)
+	t.buffer = t.buffer[:0]
+
+	slices.SortFunc(all, func(a, b centroid) int {
+		return cmp.Compare(a.mean, b.mean)
+	})
+
+	compression := t.getCompression()
+	merged := make([]centroid, 0, int(compression))
+
+	current := all[0]
+	weightSoFar := 0.0
+
+	for _, next := range all[1:] {
+		proposed := current.weight + next.weight
+
+		kLeft := scale(weightSoFar/t.count, compression)
+		kRight := scale((weightSoFar+proposed)/t.count, compression)
+
+		if kRight-kLeft <= 1 {
+			current.mean += (next.mean - current.mean) * next.weight / proposed
+			current.weight = proposed
+
+			continue
+		}
+
+		merged = append(merged, current)
+		weightSoFar += current.weight
+		current = next
+	}
+
+	t.centroids = append(merged, current)
+}
+
+func (t *TDigest) getCompression() float64 {
+	if t.compression == 0 {
+		return DefaultCompression
+	}
+
+	return t.compression
+}
+
+// scale is the k1 scale function of the t-digest, mapping a quantile to a
+// scale where each centroid may span at most one unit.
+func scale(q, compression float64) float64 {
+	return compression / (2 * math.Pi) * math.Asin(2*min(q, 1)-1) //nolint:mnd
+}
+
+func interpolate(from, to, fraction float64) float64 {
+	return from + (to-from)*fraction
+}
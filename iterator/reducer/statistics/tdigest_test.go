@@ -0,0 +1,142 @@
+package statistics_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer/statistics"
+)
+
+func ExampleApproxQuantiles() {
+	i := iterator.FromToInclusive(1, 10_000)
+	acc := statistics.NewTDigest(statistics.DefaultCompression)
+
+	iterator.Reduce(i, &acc, statistics.ApproxQuantiles[int])
+
+	fmt.Printf("p50: %.0f\n", acc.Quantile(0.5))
+	fmt.Printf("p99: %.0f\n", acc.Quantile(0.99))
+	fmt.Printf("max: %.0f\n", acc.Quantile(1))
+
+	// Output:
+	// p50: 5000
+	// p99: 9900
+	// max: 10000
+}
+
+func TestTDigest_errorBound(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const n = 100_000
+
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(42))
+
+	values := make([]float64, n)
+	for i := range values {
+		values[i] = rand.ExpFloat64()
+	}
+
+	digest := statistics.TDigest{}
+	iterator.Reduce(iterator.Of(values...), &digest, statistics.ApproxQuantiles[float64])
+
+	slices.Sort(values)
+
+	for _, q := range []float64{0.001, 0.01, 0.1, 0.25, 0.5, 0.75, 0.9, 0.95, 0.99, 0.999} {
+		t.Run(fmt.Sprintf("q=%v", q), func(t *testing.T) {
+			// Act
+			estimate := digest.Quantile(q)
+
+			// Assert
+			rank := float64(sort.SearchFloat64s(values, estimate)) / n
+			bound := math.Pi * math.Sqrt(q*(1-q)) / statistics.DefaultCompression
+
+			assert.InDelta(t, q, rank, bound)
+		})
+	}
+}
+
+func TestTDigest_manyValues(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	digest := statistics.NewTDigest(50)
+
+	// Act
+	iterator.Reduce(iterator.FromTo(0, 1_000_000), &digest, statistics.ApproxQuantiles[int])
+
+	// Assert
+	assert.Equal(t, 1_000_000, digest.Count())
+	assert.InDelta(t, 0.0, digest.Quantile(0), 0)
+	assert.InDelta(t, 999_999.0, digest.Quantile(1), 0)
+	assert.InDelta(t, 500_000.0, digest.Median(), 1_000_000.0/50)
+}
+
+func TestTDigest_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	shards := make([]statistics.TDigest, 4)
+	for i := range 100_000 {
+		shards[i%len(shards)].Add(float64(i))
+	}
+
+	// Act
+	merged := statistics.TDigest{}
+	for i := range shards {
+		merged.Merge(&shards[i])
+	}
+
+	// Assert
+	assert.Equal(t, 100_000, merged.Count())
+	assert.InDelta(t, 0.0, merged.Quantile(0), 0)
+	assert.InDelta(t, 99_999.0, merged.Quantile(1), 0)
+
+	for _, q := range []float64{0.01, 0.5, 0.95, 0.99} {
+		bound := math.Pi * math.Sqrt(q*(1-q)) / statistics.DefaultCompression * 100_000
+		assert.InDelta(t, q*100_000, merged.Quantile(q), bound)
+	}
+}
+
+func TestTDigest_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	digest := statistics.TDigest{}
+
+	// Act
+	got := digest.Median()
+
+	// Assert
+	assert.True(t, math.IsNaN(got))
+	assert.Equal(t, 0, digest.Count())
+}
+
+func TestTDigest_singleValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	digest := statistics.TDigest{}
+
+	// Act
+	digest.Add(3.14)
+
+	// Assert
+	assert.InDelta(t, 3.14, digest.Quantile(0), 0)
+	assert.InDelta(t, 3.14, digest.Median(), 0)
+	assert.InDelta(t, 3.14, digest.Quantile(1), 0)
+}
+
+func TestNewTDigest_invalidCompression(t *testing.T) {
+	t.Parallel()
+
+	assert.Panics(t, func() { statistics.NewTDigest(0) })
+	assert.Panics(t, func() { statistics.NewTDigest(-1) })
+}
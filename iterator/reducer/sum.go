@@ -0,0 +1,141 @@
+package reducer
+
+import (
+	"errors"
+	"math"
+	"unsafe"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+)
+
+// ErrOverflow is returned when an integer sum exceeds the range of its type.
+var ErrOverflow = errors.New("integer overflow")
+
+// CheckedSum adds the given value to acc and records, if the sum overflows
+// the range of T, instead of silently wrapping around like [Sum].
+func CheckedSum[T constraints.Integer](acc *CheckedSumAccumulator[T], in T) {
+	if acc.overflowed {
+		return
+	}
+
+	sum, overflow := addOverflows(acc.sum, in)
+	if overflow != 0 {
+		acc.overflowed = true
+		return
+	}
+
+	acc.sum = sum
+}
+
+// CheckedSumAccumulator is the accumulator type for the [CheckedSum] reducer.
+//
+// The zero value is an accumulator with sum 0 ready to use.
+type CheckedSumAccumulator[T constraints.Integer] struct {
+	sum        T
+	overflowed bool
+}
+
+// Sum returns the sum of all gathered values, or [ErrOverflow] if it
+// overflowed.
+func (c CheckedSumAccumulator[T]) Sum() (T, error) {
+	if c.overflowed {
+		return 0, ErrOverflow
+	}
+
+	return c.sum, nil
+}
+
+// Overflowed reports whether the sum overflowed.
+func (c CheckedSumAccumulator[T]) Overflowed() bool {
+	return c.overflowed
+}
+
+// SaturatingSum adds the given value to acc, but instead of wrapping around
+// on overflow, the sum is clamped to the minimum or maximum value of T.
+func SaturatingSum[T constraints.Integer](acc *T, in T) {
+	sum, overflow := addOverflows(*acc, in)
+
+	switch {
+	case overflow > 0:
+		_, *acc = integerLimits[T]()
+	case overflow < 0:
+		*acc, _ = integerLimits[T]()
+	default:
+		*acc = sum
+	}
+}
+
+// KahanSum adds the given value to acc using Kahan summation, which keeps
+// track of the rounding error lost in each addition, so the error does not
+// grow with the number of values like it does with [Sum].
+func KahanSum[T constraints.Float](acc *CompensatedSumAccumulator[T], in T) {
+	y := in + acc.compensation
+	t := acc.sum + y
+
+	acc.compensation = y - (t - acc.sum)
+	acc.sum = t
+}
+
+// NeumaierSum adds the given value to acc using Neumaier's improved Kahan
+// summation, which is also accurate, when the values added are larger than
+// the sum so far.
+func NeumaierSum[T constraints.Float](acc *CompensatedSumAccumulator[T], in T) {
+	t := acc.sum + in
+
+	if abs(acc.sum) >= abs(in) {
+		acc.compensation += (acc.sum - t) + in
+	} else {
+		acc.compensation += (in - t) + acc.sum
+	}
+
+	acc.sum = t
+}
+
+// CompensatedSumAccumulator is the accumulator type for the [KahanSum] and
+// [NeumaierSum] reducers.
+//
+// The zero value is an accumulator with sum 0 ready to use.
+type CompensatedSumAccumulator[T constraints.Float] struct {
+	sum          T
+	compensation T
+}
+
+// Sum returns the compensated sum of all gathered values.
+func (c CompensatedSumAccumulator[T]) Sum() T {
+	return c.sum + c.compensation
+}
+
+// addOverflows returns a+b and whether it overflowed: 1 if it exceeded the
+// maximum, -1 if it fell below the minimum of T and 0 if it didn't overflow.
+func addOverflows[T constraints.Integer](a, b T) (sum T, overflow int) {
+	sum = a + b
+
+	switch {
+	case b > 0 && sum < a:
+		return sum, 1
+	case b < 0 && sum > a:
+		return sum, -1
+	default:
+		return sum, 0
+	}
+}
+
+// integerLimits returns the minimum and maximum value of T.
+func integerLimits[T constraints.Integer]() (minValue, maxValue T) {
+	var zero T
+
+	// For unsigned types, this wraps around to the maximum value.
+	allBitsSet := zero - 1
+	if allBitsSet > 0 {
+		return 0, allBitsSet
+	}
+
+	bits := unsafe.Sizeof(zero) * 8 //nolint:mnd
+	maxValue = T(1)<<(bits-1) - 1
+
+	return -maxValue - 1, maxValue
+}
+
+func abs[T constraints.Float](v T) T {
+	return T(math.Abs(float64(v)))
+}
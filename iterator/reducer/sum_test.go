@@ -0,0 +1,147 @@
+package reducer_test
+
+import (
+	"fmt"
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/iterator"
+	"github.com/KrischanCS/go-toolbox/iterator/reducer"
+)
+
+func ExampleCheckedSum() {
+	i := iterator.Of[int8](100, 20, 7, 1)
+
+	acc := reducer.CheckedSumAccumulator[int8]{}
+	iterator.Reduce(i, &acc, reducer.CheckedSum)
+
+	_, err := acc.Sum()
+	fmt.Println(err)
+
+	// Output: integer overflow
+}
+
+func ExampleSaturatingSum() {
+	i := iterator.Of[uint8](200, 50, 10)
+
+	var sum uint8
+	iterator.Reduce(i, &sum, reducer.SaturatingSum)
+
+	fmt.Println(sum)
+
+	// Output: 255
+}
+
+func ExampleNeumaierSum() {
+	i := iterator.Of(1.0, 1e100, 1.0, -1e100)
+
+	naive := 0.0
+	iterator.Reduce(i, &naive, reducer.Sum)
+
+	acc := reducer.CompensatedSumAccumulator[float64]{}
+	iterator.Reduce(i, &acc, reducer.NeumaierSum)
+
+	fmt.Println(naive, acc.Sum())
+
+	// Output: 0 2
+}
+
+func TestIntegerSums_limits(t *testing.T) {
+	t.Parallel()
+
+	t.Run("int", func(t *testing.T) { testIntegerSums[int](t, math.MinInt, math.MaxInt) })
+	t.Run("int8", func(t *testing.T) { testIntegerSums[int8](t, math.MinInt8, math.MaxInt8) })
+	t.Run("int16", func(t *testing.T) { testIntegerSums[int16](t, math.MinInt16, math.MaxInt16) })
+	t.Run("int32", func(t *testing.T) { testIntegerSums[int32](t, math.MinInt32, math.MaxInt32) })
+	t.Run("int64", func(t *testing.T) { testIntegerSums[int64](t, math.MinInt64, math.MaxInt64) })
+	t.Run("uint", func(t *testing.T) { testIntegerSums[uint](t, 0, math.MaxUint) })
+	t.Run("uint8", func(t *testing.T) { testIntegerSums[uint8](t, 0, math.MaxUint8) })
+	t.Run("uint16", func(t *testing.T) { testIntegerSums[uint16](t, 0, math.MaxUint16) })
+	t.Run("uint32", func(t *testing.T) { testIntegerSums[uint32](t, 0, math.MaxUint32) })
+	t.Run("uint64", func(t *testing.T) { testIntegerSums[uint64](t, 0, math.MaxUint64) })
+	t.Run("uintptr", func(t *testing.T) { testIntegerSums[uintptr](t, 0, math.MaxUint) })
+}
+
+func testIntegerSums[T constraints.Integer](t *testing.T, minValue, maxValue T) {
+	t.Helper()
+
+	checked := reducer.CheckedSumAccumulator[T]{}
+	iterator.Reduce(iterator.Of(maxValue-1, 1), &checked, reducer.CheckedSum[T])
+
+	sum, err := checked.Sum()
+	require.NoError(t, err)
+	assert.Equal(t, maxValue, sum)
+
+	reducer.CheckedSum(&checked, 1)
+	reducer.CheckedSum(&checked, minValue)
+
+	_, err = checked.Sum()
+	assert.ErrorIs(t, err, reducer.ErrOverflow)
+	assert.True(t, checked.Overflowed())
+
+	saturated := maxValue - 1
+	iterator.Reduce(iterator.Of(1, 1, maxValue), &saturated, reducer.SaturatingSum[T])
+	assert.Equal(t, maxValue, saturated)
+
+	if minValue == 0 {
+		return
+	}
+
+	minusOne := minValue - minValue - 1
+
+	checked = reducer.CheckedSumAccumulator[T]{}
+	iterator.Reduce(iterator.Of(minValue+1, minusOne), &checked, reducer.CheckedSum[T])
+
+	sum, err = checked.Sum()
+	require.NoError(t, err)
+	assert.Equal(t, minValue, sum)
+
+	reducer.CheckedSum(&checked, minusOne)
+	assert.True(t, checked.Overflowed())
+
+	saturated = minValue + 1
+	iterator.Reduce(iterator.Of(minValue, minValue), &saturated, reducer.SaturatingSum[T])
+	assert.Equal(t, minValue, saturated)
+
+	reducer.SaturatingSum(&saturated, maxValue)
+	assert.Equal(t, minusOne, saturated)
+}
+
+func TestCompensatedSums(t *testing.T) {
+	t.Parallel()
+
+	t.Run("float32", func(t *testing.T) { testCompensatedSums[float32](t, math.MaxFloat32, 1e-4) })
+	t.Run("float64", func(t *testing.T) { testCompensatedSums[float64](t, math.MaxFloat64, 1e-12) })
+}
+
+func testCompensatedSums[T constraints.Float](t *testing.T, maxValue T, epsilon float64) {
+	t.Helper()
+
+	const n = 1_000_000
+
+	for name, fn := range map[string]func(*reducer.CompensatedSumAccumulator[T], T){
+		"Kahan":    reducer.KahanSum[T],
+		"Neumaier": reducer.NeumaierSum[T],
+	} {
+		// 0.1 is not exactly representable, so naive summation drifts away.
+		acc := reducer.CompensatedSumAccumulator[T]{}
+		naive := T(0)
+
+		for range n {
+			fn(&acc, 0.1)
+			reducer.Sum(&naive, 0.1)
+		}
+
+		assert.InEpsilon(t, n*float64(T(0.1)), float64(acc.Sum()), epsilon, name)
+		assert.Greater(t, math.Abs(n*float64(T(0.1))-float64(naive)), math.Abs(n*float64(T(0.1))-float64(acc.Sum())))
+
+		acc = reducer.CompensatedSumAccumulator[T]{}
+		iterator.Reduce(iterator.Of(maxValue/2, maxValue/2), &acc, fn)
+
+		assert.Equal(t, maxValue, acc.Sum(), name) //nolint:testifylint
+	}
+}
@@ -0,0 +1,124 @@
+package optional
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+)
+
+// fieldState distinguishes the states of a [Field]. The zero value is absent.
+type fieldState uint8
+
+const (
+	absent fieldState = iota
+	null
+	present
+)
+
+// Field represents a value of type T in a JSON object, which may be absent,
+// explicitly null or present, e.g. for PATCH requests, where an absent field
+// must not be changed, while null removes the value.
+//
+// Unlike [Optional], it distinguishes a missing field from null when
+// unmarshalling. When marshalling, absent fields are only omitted with the
+// 'omitzero' option, because 'omitempty' does not apply to structs, so they are
+// encoded as null otherwise.
+//
+// The zero value is an absent Field.
+type Field[T any] struct {
+	value T
+	state fieldState
+}
+
+// FieldOf creates a new present Field with the given value.
+func FieldOf[T any](value T) Field[T] {
+	return Field[T]{value: value, state: present}
+}
+
+// NullField creates a new Field, which is explicitly null.
+func NullField[T any]() Field[T] {
+	return Field[T]{state: null}
+}
+
+// AbsentField creates a new absent Field, which is the same as the zero value.
+func AbsentField[T any]() Field[T] {
+	return Field[T]{}
+}
+
+// Get returns the value if present, and a boolean indicating its presence.
+func (f Field[T]) Get() (value T, ok bool) {
+	return f.value, f.state == present
+}
+
+// IsAbsent returns true if the field is neither null nor present.
+func (f Field[T]) IsAbsent() bool {
+	return f.state == absent
+}
+
+// IsNull returns true if the field is explicitly null.
+func (f Field[T]) IsNull() bool {
+	return f.state == null
+}
+
+// IsZero returns true if the field is absent, so it is omitted when marshalling
+// with the 'omitzero' option.
+func (f Field[T]) IsZero() bool {
+	return f.state == absent
+}
+
+// Optional converts the field to an [Optional], which is empty if the field is
+// absent or null.
+func (f Field[T]) Optional() Optional[T] {
+	return Optional[T]{value: f.value, present: f.state == present}
+}
+
+// String returns a string representation in the format:
+//   - If Present: (Field[{{type}}]: {{value}})
+//   - If Null: (Field[{{type}}] <null>)
+//   - If Absent: (Field[{{type}}] <absent>)
+func (f Field[T]) String() string {
+	switch f.state {
+	case null:
+		return fmt.Sprintf("(Field[%T] <null>)", f.value)
+	case present:
+		return fmt.Sprintf("(Field[%T]: %v)", f.value, f.value)
+	default:
+		return fmt.Sprintf("(Field[%T] <absent>)", f.value)
+	}
+}
+
+// MarshalJSON encodes the value if present, otherwise 'null'.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if f.state != present {
+		return []byte("null"), nil
+	}
+
+	return json.Marshal(f.value)
+}
+
+// UnmarshalJSON creates a null Field if the value is 'null', otherwise a
+// present one. Fields missing in the JSON object are not unmarshalled, so they
+// stay absent.
+func (f *Field[T]) UnmarshalJSON(d []byte) error {
+	if string(d) == "null" {
+		*f = NullField[T]()
+
+		return nil
+	}
+
+	var value T
+
+	err := json.Unmarshal(d, &value)
+	if err != nil {
+		return err
+	}
+
+	*f = FieldOf(value)
+
+	return nil
+}
+
+// reflectState returns the state and value of the field for [ApplyMergePatch].
+func (f Field[T]) reflectState() (fieldState, reflect.Value) {
+	return f.state, reflect.ValueOf(&f.value).Elem()
+}
@@ -0,0 +1,217 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleField_MarshalJSON() {
+	type Test struct {
+		Value1 optional.Field[string] `json:"value1,omitzero"`
+		Value2 optional.Field[string] `json:"value2,omitzero"`
+		Value3 optional.Field[string] `json:"value3,omitzero"`  // omitzero omits an absent field
+		Value4 optional.Field[string] `json:"value4,omitempty"` // omitempty has no effect on structs
+	}
+
+	t := Test{
+		Value1: optional.FieldOf("value1"),
+		Value2: optional.NullField[string](),
+	}
+
+	jsonBytes, err := json.MarshalIndent(t, "", "  ")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(string(jsonBytes))
+
+	// Output:
+	// {
+	//   "value1": "value1",
+	//   "value2": null,
+	//   "value4": null
+	// }
+}
+
+func ExampleField_UnmarshalJSON() {
+	type Test struct {
+		Value1 optional.Field[string] `json:"value1"`
+		Value2 optional.Field[string] `json:"value2"`
+		Value3 optional.Field[string] `json:"value3"`
+	}
+
+	var t Test
+
+	err := json.Unmarshal([]byte(`{"value1": "value1", "value2": null}`), &t)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(t.Value1)
+	fmt.Println(t.Value2)
+	fmt.Println(t.Value3)
+
+	// Output:
+	// (Field[string]: value1)
+	// (Field[string] <null>)
+	// (Field[string] <absent>)
+}
+
+func TestField_states(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name       string
+		field      optional.Field[int]
+		wantAbsent bool
+		wantNull   bool
+		wantValue  int
+		wantOk     bool
+	}
+
+	tests := []test{
+		{
+			name:       "Zero value should be absent",
+			field:      optional.Field[int]{},
+			wantAbsent: true,
+		},
+		{
+			name:       "AbsentField should be absent",
+			field:      optional.AbsentField[int](),
+			wantAbsent: true,
+		},
+		{
+			name:     "NullField should be null",
+			field:    optional.NullField[int](),
+			wantNull: true,
+		},
+		{
+			name:      "FieldOf should be present",
+			field:     optional.FieldOf(42),
+			wantValue: 42,
+			wantOk:    true,
+		},
+		{
+			name:   "FieldOf zero value should be present",
+			field:  optional.FieldOf(0),
+			wantOk: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			value, ok := tc.field.Get()
+
+			// Assert
+			assert.Equal(t, tc.wantAbsent, tc.field.IsAbsent())
+			assert.Equal(t, tc.wantAbsent, tc.field.IsZero())
+			assert.Equal(t, tc.wantNull, tc.field.IsNull())
+			assert.Equal(t, tc.wantOk, ok)
+			assert.Equal(t, tc.wantValue, value)
+			assert.Equal(t, tc.wantOk, tc.field.Optional() == optional.Of(tc.wantValue))
+		})
+	}
+}
+
+func TestField_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		Name  optional.Field[string] `json:"name"`
+		Count optional.Field[int]    `json:"count"`
+	}
+
+	type test struct {
+		name      string
+		input     string
+		wantName  optional.Field[string]
+		wantCount optional.Field[int]
+	}
+
+	tests := []test{
+		{
+			name:  "Should stay absent if fields are missing",
+			input: `{}`,
+		},
+		{
+			name:      "Should be null if fields are null",
+			input:     `{"name": null, "count": null}`,
+			wantName:  optional.NullField[string](),
+			wantCount: optional.NullField[int](),
+		},
+		{
+			name:      "Should be present if fields have values",
+			input:     `{"name": "", "count": 3}`,
+			wantName:  optional.FieldOf(""),
+			wantCount: optional.FieldOf(3),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var got payload
+
+			// Act
+			err := json.Unmarshal([]byte(tc.input), &got)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.wantName, got.Name)
+			assert.Equal(t, tc.wantCount, got.Count)
+		})
+	}
+}
+
+func TestField_UnmarshalJSON_invalid(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var f optional.Field[int]
+
+	// Act
+	err := json.Unmarshal([]byte(`"abc"`), &f)
+
+	// Assert
+	assert.Error(t, err)
+	assert.True(t, f.IsAbsent())
+}
+
+func TestField_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	type payload struct {
+		A optional.Field[[]int] `json:"a,omitzero"`
+		B optional.Field[[]int] `json:"b,omitzero"`
+		C optional.Field[[]int] `json:"c,omitzero"`
+	}
+
+	want := payload{
+		A: optional.FieldOf([]int{1, 2}),
+		B: optional.NullField[[]int](),
+	}
+
+	// Act
+	data, err := json.Marshal(want)
+	assert.NoError(t, err)
+
+	var got payload
+
+	err = json.Unmarshal(data, &got)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.JSONEq(t, `{"a":[1,2],"b":null}`, string(data))
+	assert.Equal(t, want, got)
+}
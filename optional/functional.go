@@ -0,0 +1,101 @@
+package optional
+
+import (
+	"fmt"
+	"iter"
+)
+
+// Map applies fn to the value of o if present and returns the result as
+// Optional, otherwise an empty Optional.
+func Map[T, R any](o Optional[T], fn func(T) R) Optional[R] {
+	if !o.present {
+		return Empty[R]()
+	}
+
+	return Of(fn(o.value))
+}
+
+// FlatMap applies fn to the value of o if present and returns its result,
+// otherwise an empty Optional.
+func FlatMap[T, R any](o Optional[T], fn func(T) Optional[R]) Optional[R] {
+	if !o.present {
+		return Empty[R]()
+	}
+
+	return fn(o.value)
+}
+
+// Filter returns o if its value is present and keep returns true for it,
+// otherwise an empty Optional.
+func Filter[T any](o Optional[T], keep func(T) bool) Optional[T] {
+	if !o.present || !keep(o.value) {
+		return Empty[T]()
+	}
+
+	return o
+}
+
+// OrElse returns the value of o if present, otherwise fallback.
+func OrElse[T any](o Optional[T], fallback T) T {
+	if !o.present {
+		return fallback
+	}
+
+	return o.value
+}
+
+// OrElseGet returns the value of o if present, otherwise the result of
+// fallback, which is only called if needed.
+func OrElseGet[T any](o Optional[T], fallback func() T) T {
+	if !o.present {
+		return fallback()
+	}
+
+	return o.value
+}
+
+// OrZero returns the value of o if present, otherwise the zero value of T.
+func OrZero[T any](o Optional[T]) T {
+	if !o.present {
+		var zero T
+
+		return zero
+	}
+
+	return o.value
+}
+
+// MustGet returns the value of o and panics if it is empty.
+func MustGet[T any](o Optional[T]) T {
+	if !o.present {
+		panic(fmt.Sprintf("MustGet called on empty Optional[%T]", o.value))
+	}
+
+	return o.value
+}
+
+// IfPresent calls fn with the value of o if present.
+func IfPresent[T any](o Optional[T], fn func(T)) {
+	if o.present {
+		fn(o.value)
+	}
+}
+
+// Or returns o if its value is present, otherwise other.
+func Or[T any](o, other Optional[T]) Optional[T] {
+	if !o.present {
+		return other
+	}
+
+	return o
+}
+
+// All creates an iterator, which yields the value if present and nothing
+// otherwise.
+func (o Optional[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.present {
+			yield(o.value)
+		}
+	}
+}
@@ -0,0 +1,130 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleMap() {
+	port := optional.Of("8080")
+
+	parsed := optional.FlatMap(port, func(s string) optional.Optional[int] {
+		p, err := strconv.Atoi(s)
+		if err != nil {
+			return optional.Empty[int]()
+		}
+
+		return optional.Of(p)
+	})
+
+	next := optional.Map(parsed, func(p int) int { return p + 1 })
+
+	fmt.Println(next)
+	fmt.Println(optional.OrElse(optional.Filter(next, func(p int) bool { return p < 1024 }), 80))
+
+	// Output:
+	// (Optional[int]: 8081)
+	// 80
+}
+
+func ExampleOptional_All() {
+	for v := range optional.Of("present").All() {
+		fmt.Println(v)
+	}
+
+	for v := range optional.Empty[string]().All() {
+		fmt.Println(v)
+	}
+
+	// Output: present
+}
+
+//nolint:funlen
+func TestFunctional(t *testing.T) {
+	t.Parallel()
+
+	present := optional.Of(21)
+	empty := optional.Empty[int]()
+
+	double := func(v int) int { return 2 * v }
+	positive := func(v int) bool { return v > 0 }
+	fallback := func() int { return -1 }
+
+	t.Run("present", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, optional.Of(42), optional.Map(present, double))
+		assert.Equal(t, optional.Of("21"), optional.FlatMap(present, func(v int) optional.Optional[string] {
+			return optional.Of(strconv.Itoa(v))
+		}))
+		assert.Equal(t, empty, optional.FlatMap(present, func(int) optional.Optional[int] { return empty }))
+		assert.Equal(t, present, optional.Filter(present, positive))
+		assert.Equal(t, empty, optional.Filter(present, func(int) bool { return false }))
+		assert.Equal(t, 21, optional.OrElse(present, 7))
+		assert.Equal(t, 21, optional.OrElseGet(present, func() int { panic("must not be called") }))
+		assert.Equal(t, 21, optional.OrZero(present))
+		assert.Equal(t, 21, optional.MustGet(present))
+		assert.Equal(t, present, optional.Or(present, optional.Of(7)))
+		assert.Equal(t, []int{21}, slices.Collect(present.All()))
+
+		called := 0
+		optional.IfPresent(present, func(v int) { called += v })
+		assert.Equal(t, 21, called)
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, empty, optional.Map(empty, double))
+		assert.Equal(t, optional.Empty[string](), optional.FlatMap(empty, func(int) optional.Optional[string] {
+			panic("must not be called")
+		}))
+		assert.Equal(t, empty, optional.Filter(empty, positive))
+		assert.Equal(t, 7, optional.OrElse(empty, 7))
+		assert.Equal(t, -1, optional.OrElseGet(empty, fallback))
+		assert.Equal(t, 0, optional.OrZero(empty))
+		assert.Panics(t, func() { optional.MustGet(empty) })
+		assert.Equal(t, optional.Of(7), optional.Or(empty, optional.Of(7)))
+		assert.Equal(t, empty, optional.Or(empty, empty))
+		assert.Empty(t, slices.Collect(empty.All()))
+
+		optional.IfPresent(empty, func(int) { panic("must not be called") })
+	})
+}
+
+func TestOptional_All_break(t *testing.T) {
+	t.Parallel()
+
+	for range optional.Of(1).All() {
+		break
+	}
+}
+
+func TestOrZero_emptyWithStaleValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	type point struct {
+		X, Y int
+	}
+
+	var o optional.Optional[point]
+
+	// Decoding fails at Y after X was already written.
+	err := json.Unmarshal([]byte(`{"X": 99, "Y": "2"}`), &o)
+	require.Error(t, err)
+
+	// Act
+	got := optional.OrZero(o)
+
+	// Assert
+	assert.Equal(t, point{}, got)
+}
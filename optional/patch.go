@@ -0,0 +1,162 @@
+package optional
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// ErrIncompatiblePatch is returned by [ApplyMergePatch] when a field of the
+// patch can't be applied to the target.
+var ErrIncompatiblePatch = errors.New("incompatible patch")
+
+// patchField is implemented by all [Field] types.
+type patchField interface {
+	reflectState() (fieldState, reflect.Value)
+}
+
+// valueSetter is implemented by all *[Optional] types.
+type valueSetter interface {
+	setValue(value reflect.Value) bool
+}
+
+// ApplyMergePatch applies patch, a struct of [Field]s, onto the struct target
+// points to, following the semantics of JSON Merge Patch (RFC 7386):
+//   - Absent fields and nil pointers to Fields leave the target field unchanged.
+//   - Null fields set the target field to its zero value, e.g. nil or an empty
+//     [Optional].
+//   - Present fields replace the target field. If the value is a struct
+//     containing Fields itself, it is applied recursively onto the target
+//     field instead, allocating it if it is a nil pointer.
+//
+// The fields of patch are matched to the fields of target by name, other
+// fields of patch are ignored. A present value is assigned to a target field
+// of the same type, a pointer to it or an [Optional] of it.
+//
+// Returns [ErrIncompatiblePatch] if target is nil, patch is no struct or a
+// pointer to one, or a field of the patch does not exist in target or has an
+// incompatible type. target may be partially patched then.
+func ApplyMergePatch[T, P any](target *T, patch P) error {
+	if target == nil {
+		return fmt.Errorf("%w: target %T is nil", ErrIncompatiblePatch, target)
+	}
+
+	targetValue := reflect.ValueOf(target).Elem()
+	if targetValue.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: target %T is no pointer to a struct", ErrIncompatiblePatch, target)
+	}
+
+	patchValue := reflect.ValueOf(patch)
+	if patchValue.Kind() == reflect.Pointer {
+		patchValue = patchValue.Elem()
+	}
+
+	if patchValue.Kind() != reflect.Struct {
+		return fmt.Errorf("%w: patch %T is no struct", ErrIncompatiblePatch, patch)
+	}
+
+	return applyStruct(targetValue, patchValue)
+}
+
+func applyStruct(target, patch reflect.Value) error {
+	for i := range patch.NumField() {
+		fieldType := patch.Type().Field(i)
+		if !fieldType.IsExported() {
+			continue
+		}
+
+		fieldValue := patch.Field(i)
+		if fieldValue.Kind() == reflect.Pointer && fieldValue.IsNil() {
+			continue
+		}
+
+		field, ok := fieldValue.Interface().(patchField)
+		if !ok {
+			continue
+		}
+
+		state, value := field.reflectState()
+		if state == absent {
+			continue
+		}
+
+		targetField := target.FieldByName(fieldType.Name)
+		if !targetField.IsValid() || !targetField.CanSet() {
+			return fmt.Errorf("%w: %s has no field %s", ErrIncompatiblePatch, target.Type(), fieldType.Name)
+		}
+
+		if state == null {
+			targetField.SetZero()
+
+			continue
+		}
+
+		err := applyValue(targetField, value)
+		if err != nil {
+			return fmt.Errorf("%s: %w", fieldType.Name, err)
+		}
+	}
+
+	return nil
+}
+
+func applyValue(target, value reflect.Value) error {
+	if isPatch(value.Type()) {
+		if target.Kind() == reflect.Pointer {
+			if target.IsNil() {
+				target.Set(reflect.New(target.Type().Elem()))
+			}
+
+			target = target.Elem()
+		}
+
+		if target.Kind() != reflect.Struct {
+			return fmt.Errorf("%w: can't apply %s to %s", ErrIncompatiblePatch, value.Type(), target.Type())
+		}
+
+		return applyStruct(target, value)
+	}
+
+	switch {
+	case value.Type().AssignableTo(target.Type()):
+		target.Set(value)
+	case target.Kind() == reflect.Pointer && value.Type().AssignableTo(target.Type().Elem()):
+		p := reflect.New(target.Type().Elem())
+		p.Elem().Set(value)
+		target.Set(p)
+	default:
+		setter, ok := target.Addr().Interface().(valueSetter)
+		if !ok || !setter.setValue(value) {
+			return fmt.Errorf("%w: can't assign %s to %s", ErrIncompatiblePatch, value.Type(), target.Type())
+		}
+	}
+
+	return nil
+}
+
+// isPatch checks if t is a struct containing any [Field].
+func isPatch(t reflect.Type) bool {
+	if t.Kind() != reflect.Struct {
+		return false
+	}
+
+	for i := range t.NumField() {
+		if t.Field(i).Type.Implements(reflect.TypeFor[patchField]()) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// setValue sets the Optional to the given value, if it has type T.
+func (o *Optional[T]) setValue(value reflect.Value) bool {
+	v, ok := value.Interface().(T)
+	if !ok {
+		return false
+	}
+
+	*o = Of(v)
+
+	return true
+}
@@ -0,0 +1,279 @@
+package optional_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+type address struct {
+	Street string
+	City   string
+}
+
+type user struct {
+	Name     string
+	Nickname *string
+	Email    optional.Optional[string]
+	Age      int
+	Address  address
+	Previous *address
+}
+
+type addressPatch struct {
+	Street optional.Field[string] `json:"street"`
+	City   optional.Field[string] `json:"city"`
+}
+
+type userPatch struct {
+	Name     optional.Field[string]       `json:"name"`
+	Nickname optional.Field[string]       `json:"nickname"`
+	Email    optional.Field[string]       `json:"email"`
+	Age      optional.Field[int]          `json:"age"`
+	Address  optional.Field[addressPatch] `json:"address"`
+	Previous optional.Field[addressPatch] `json:"previous"`
+}
+
+func ExampleApplyMergePatch() {
+	type User struct {
+		Name  string
+		Email optional.Optional[string]
+		Age   int
+	}
+
+	type UserPatch struct {
+		Name  optional.Field[string] `json:"name"`
+		Email optional.Field[string] `json:"email"`
+		Age   optional.Field[int]    `json:"age"`
+	}
+
+	u := User{Name: "Alice", Email: optional.Of("alice@example.com"), Age: 30}
+
+	var patch UserPatch
+
+	err := json.Unmarshal([]byte(`{"email": null, "age": 31}`), &patch)
+	if err != nil {
+		panic(err)
+	}
+
+	err = optional.ApplyMergePatch(&u, patch)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(u.Name, u.Email, u.Age)
+
+	// Output:
+	// Alice (Optional[string] <empty>) 31
+}
+
+//nolint:funlen
+func TestApplyMergePatch(t *testing.T) {
+	t.Parallel()
+
+	nick := "ally"
+
+	base := func() user {
+		return user{
+			Name:     "Alice",
+			Nickname: &nick,
+			Email:    optional.Of("alice@example.com"),
+			Age:      30,
+			Address:  address{Street: "Main St", City: "Springfield"},
+		}
+	}
+
+	type test struct {
+		name  string
+		patch string
+		want  func() user
+	}
+
+	tests := []test{
+		{
+			name:  "Empty patch should change nothing",
+			patch: `{}`,
+			want:  base,
+		},
+		{
+			name:  "Null should set fields to zero values",
+			patch: `{"name": null, "nickname": null, "email": null, "age": null, "address": null}`,
+			want: func() user {
+				return user{}
+			},
+		},
+		{
+			name:  "Values should replace fields, pointers and optionals",
+			patch: `{"name": "Bob", "nickname": "bobby", "email": "bob@example.com", "age": 0}`,
+			want: func() user {
+				u := base()
+				u.Name = "Bob"
+				bobby := "bobby"
+				u.Nickname = &bobby
+				u.Email = optional.Of("bob@example.com")
+				u.Age = 0
+
+				return u
+			},
+		},
+		{
+			name:  "Nested patches should be merged",
+			patch: `{"address": {"city": "Shelbyville"}}`,
+			want: func() user {
+				u := base()
+				u.Address.City = "Shelbyville"
+
+				return u
+			},
+		},
+		{
+			name:  "Nested patches should allocate nil pointers",
+			patch: `{"previous": {"street": "Old St"}}`,
+			want: func() user {
+				u := base()
+				u.Previous = &address{Street: "Old St"}
+
+				return u
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var patch userPatch
+
+			err := json.Unmarshal([]byte(tc.patch), &patch)
+			assert.NoError(t, err)
+
+			got := base()
+
+			// Act
+			err = optional.ApplyMergePatch(&got, &patch)
+
+			// Assert
+			assert.NoError(t, err)
+			assert.Equal(t, tc.want(), got)
+		})
+	}
+}
+
+func TestApplyMergePatch_incompatible(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name  string
+		apply func() error
+	}
+
+	tests := []test{
+		{
+			name: "Should fail if target has no such field",
+			apply: func() error {
+				return optional.ApplyMergePatch(&address{}, struct {
+					Zip optional.Field[string]
+				}{Zip: optional.FieldOf("12345")})
+			},
+		},
+		{
+			name: "Should fail if types are incompatible",
+			apply: func() error {
+				return optional.ApplyMergePatch(&user{}, struct {
+					Age optional.Field[string]
+				}{Age: optional.FieldOf("thirty")})
+			},
+		},
+		{
+			name: "Should fail if nested patch targets no struct",
+			apply: func() error {
+				return optional.ApplyMergePatch(&user{}, struct {
+					Name optional.Field[addressPatch]
+				}{Name: optional.FieldOf(addressPatch{})})
+			},
+		},
+		{
+			name: "Should fail if target is no struct",
+			apply: func() error {
+				i := 0
+
+				return optional.ApplyMergePatch(&i, userPatch{})
+			},
+		},
+		{
+			name: "Should fail if patch is no struct",
+			apply: func() error {
+				return optional.ApplyMergePatch(&user{}, 1)
+			},
+		},
+		{
+			name: "Should fail if target is nil",
+			apply: func() error {
+				return optional.ApplyMergePatch[user](nil, userPatch{})
+			},
+		},
+		{
+			name: "Should fail if patch is a nil pointer",
+			apply: func() error {
+				return optional.ApplyMergePatch[user, *userPatch](&user{}, nil)
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			err := tc.apply()
+
+			// Assert
+			assert.ErrorIs(t, err, optional.ErrIncompatiblePatch)
+		})
+	}
+}
+
+func TestApplyMergePatch_ignoresAbsentAndOtherFields(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	got := address{Street: "Main St"}
+	city := optional.FieldOf("Springfield")
+	patch := struct {
+		Street  optional.Field[string]
+		Zip     *optional.Field[string]
+		City    *optional.Field[string]
+		Comment string
+	}{City: &city, Comment: "ignored"}
+
+	// Act
+	err := optional.ApplyMergePatch(&got, patch)
+
+	// Assert
+	assert.NoError(t, err)
+	assert.Equal(t, address{Street: "Main St", City: "Springfield"}, got)
+}
+
+func TestApplyMergePatch_errorNamesField(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	patch := struct {
+		Address optional.Field[struct{ Zip optional.Field[string] }]
+	}{
+		Address: optional.FieldOf(struct{ Zip optional.Field[string] }{Zip: optional.FieldOf("12345")}),
+	}
+
+	// Act
+	err := optional.ApplyMergePatch(&user{}, patch)
+
+	// Assert
+	require.ErrorIs(t, err, optional.ErrIncompatiblePatch)
+	assert.Equal(t, "Address: incompatible patch: optional_test.address has no field Zip", err.Error())
+}
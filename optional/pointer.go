@@ -0,0 +1,34 @@
+package optional
+
+// ToPointer returns a pointer to a copy of the value of o if present,
+// otherwise nil.
+func ToPointer[T any](o Optional[T]) *T {
+	if !o.present {
+		return nil
+	}
+
+	value := o.value
+
+	return &value
+}
+
+// FromPointer creates an Optional with the value p points to, or an empty
+// Optional if p is nil.
+func FromPointer[T any](p *T) Optional[T] {
+	if p == nil {
+		return Empty[T]()
+	}
+
+	return Of(*p)
+}
+
+// FromZero creates an Optional with the given value, or an empty Optional if
+// it is the zero value of T, e.g. for fields where the zero value means unset.
+func FromZero[T comparable](value T) Optional[T] {
+	var zero T
+	if value == zero {
+		return Empty[T]()
+	}
+
+	return Of(value)
+}
@@ -0,0 +1,63 @@
+package optional_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+func ExampleFromZero() {
+	type config struct {
+		Timeout time.Duration
+	}
+
+	timeout := optional.FromZero(config{}.Timeout)
+
+	fmt.Println(timeout)
+	fmt.Println(optional.OrElse(timeout, 30*time.Second))
+
+	// Output:
+	// (Optional[time.Duration] <empty>)
+	// 30s
+}
+
+func TestToPointer(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := optional.Of("value")
+
+	// Act
+	p := optional.ToPointer(o)
+	*p = "changed"
+
+	// Assert
+	assert.Equal(t, optional.Of("value"), o, "pointer must reference a copy")
+	assert.Nil(t, optional.ToPointer(optional.Empty[string]()))
+}
+
+func TestFromPointer(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	value := 0
+
+	// Act & Assert
+	assert.Equal(t, optional.Of(0), optional.FromPointer(&value))
+	assert.Equal(t, optional.Empty[int](), optional.FromPointer[int](nil))
+	assert.Equal(t, optional.Of(0), optional.FromPointer(optional.ToPointer(optional.Of(0))))
+}
+
+func TestFromZero(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, optional.Empty[int](), optional.FromZero(0))
+	assert.Equal(t, optional.Of(1), optional.FromZero(1))
+	assert.Equal(t, optional.Empty[string](), optional.FromZero(""))
+	assert.Equal(t, optional.Empty[*int](), optional.FromZero[*int](nil))
+	assert.Equal(t, optional.Empty[time.Time](), optional.FromZero(time.Time{}))
+}
@@ -0,0 +1,58 @@
+package optional
+
+import (
+	"database/sql"
+	"database/sql/driver"
+)
+
+// Scan implements [sql.Scanner]. A NULL value results in an empty Optional,
+// any other value is scanned into T, using T's own [sql.Scanner] if it
+// implements one, otherwise the same conversions as [sql.Rows.Scan].
+//
+// If scanning fails, the Optional is empty.
+func (o *Optional[T]) Scan(src any) error {
+	if src == nil {
+		*o = Empty[T]()
+
+		return nil
+	}
+
+	var (
+		value T
+		err   error
+	)
+
+	if scanner, ok := any(&value).(sql.Scanner); ok {
+		err = scanner.Scan(src)
+	} else {
+		var n sql.Null[T]
+
+		err = n.Scan(src)
+		value = n.V
+	}
+
+	if err != nil {
+		*o = Empty[T]()
+
+		return err
+	}
+
+	*o = Of(value)
+
+	return nil
+}
+
+// Value implements [driver.Valuer]. An empty Optional results in NULL, a
+// present value is converted by T's own [driver.Valuer] if it implements one,
+// otherwise by [driver.DefaultParameterConverter].
+func (o Optional[T]) Value() (driver.Value, error) {
+	if !o.present {
+		return nil, nil
+	}
+
+	if valuer, ok := any(o.value).(driver.Valuer); ok {
+		return valuer.Value()
+	}
+
+	return driver.DefaultParameterConverter.ConvertValue(o.value)
+}
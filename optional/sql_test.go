@@ -0,0 +1,440 @@
+package optional_test
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"io"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// fakeDB returns a database, which returns the given values as single row for
+// every query and records the arguments of every query and exec.
+type fakeDB struct {
+	row  []driver.Value
+	args []driver.Value
+}
+
+func (f *fakeDB) Connect(context.Context) (driver.Conn, error) { return f, nil }
+func (f *fakeDB) Driver() driver.Driver                        { return nil }
+func (f *fakeDB) Prepare(string) (driver.Stmt, error)          { return f, nil }
+func (f *fakeDB) Begin() (driver.Tx, error)                    { return nil, errors.ErrUnsupported }
+func (f *fakeDB) Close() error                                 { return nil }
+func (f *fakeDB) NumInput() int                                { return -1 }
+
+func (f *fakeDB) Exec(args []driver.Value) (driver.Result, error) {
+	f.args = args
+
+	return driver.RowsAffected(1), nil
+}
+
+func (f *fakeDB) Query(args []driver.Value) (driver.Rows, error) {
+	f.args = args
+
+	return &fakeRows{row: f.row}, nil
+}
+
+type fakeRows struct {
+	row  []driver.Value
+	done bool
+}
+
+func (r *fakeRows) Columns() []string {
+	columns := make([]string, len(r.row))
+	for i := range columns {
+		columns[i] = "c" + strconv.Itoa(i)
+	}
+
+	return columns
+}
+
+func (r *fakeRows) Close() error { return nil }
+
+func (r *fakeRows) Next(dest []driver.Value) error {
+	if r.done {
+		return io.EOF
+	}
+
+	r.done = true
+	copy(dest, r.row)
+
+	return nil
+}
+
+func openFakeDB(t *testing.T, row ...driver.Value) (*sql.DB, *fakeDB) {
+	t.Helper()
+
+	fake := &fakeDB{row: row}
+	db := sql.OpenDB(fake)
+
+	t.Cleanup(func() { _ = db.Close() })
+
+	return db, fake
+}
+
+// coordinate is stored as "x,y" text and implements its own conversions.
+type coordinate struct {
+	X, Y int
+}
+
+func (c *coordinate) Scan(src any) error {
+	s, ok := src.(string)
+	if !ok {
+		return fmt.Errorf("can't scan %T into coordinate", src)
+	}
+
+	_, err := fmt.Sscanf(s, "%d,%d", &c.X, &c.Y)
+
+	return err
+}
+
+func (c coordinate) Value() (driver.Value, error) {
+	return fmt.Sprintf("%d,%d", c.X, c.Y), nil
+}
+
+// status has no own conversions, but a string kind.
+type status string
+
+func ExampleOptional_Scan() {
+	db := sql.OpenDB(&fakeDB{row: []driver.Value{"Alice", nil}})
+	defer db.Close()
+
+	var name, email optional.Optional[string]
+
+	err := db.QueryRow("SELECT name, email FROM users").Scan(&name, &email)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(name)
+	fmt.Println(email)
+
+	// Output:
+	// (Optional[string]: Alice)
+	// (Optional[string] <empty>)
+}
+
+//nolint:funlen
+func TestOptional_Scan(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	type test struct {
+		name string
+		src  driver.Value
+		scan func(row *sql.Row) (any, error)
+		want any
+	}
+
+	tests := []test{
+		{
+			name: "Should scan NULL as empty",
+			src:  nil,
+			scan: scanInto[int64],
+			want: optional.Empty[int64](),
+		},
+		{
+			name: "Should scan int64",
+			src:  int64(42),
+			scan: scanInto[int64],
+			want: optional.Of(int64(42)),
+		},
+		{
+			name: "Should convert int64 to int32",
+			src:  int64(42),
+			scan: scanInto[int32],
+			want: optional.Of(int32(42)),
+		},
+		{
+			name: "Should convert text to float64",
+			src:  []byte("3.14"),
+			scan: scanInto[float64],
+			want: optional.Of(3.14),
+		},
+		{
+			name: "Should convert int64 to string",
+			src:  int64(7),
+			scan: scanInto[string],
+			want: optional.Of("7"),
+		},
+		{
+			name: "Should scan bool",
+			src:  true,
+			scan: scanInto[bool],
+			want: optional.Of(true),
+		},
+		{
+			name: "Should scan time.Time",
+			src:  now,
+			scan: scanInto[time.Time],
+			want: optional.Of(now),
+		},
+		{
+			name: "Should scan NULL time.Time as empty",
+			src:  nil,
+			scan: scanInto[time.Time],
+			want: optional.Empty[time.Time](),
+		},
+		{
+			name: "Should scan []byte",
+			src:  []byte{0xde, 0xad},
+			scan: scanInto[[]byte],
+			want: optional.Of([]byte{0xde, 0xad}),
+		},
+		{
+			name: "Should scan NULL []byte as empty",
+			src:  nil,
+			scan: scanInto[[]byte],
+			want: optional.Empty[[]byte](),
+		},
+		{
+			name: "Should use Scanner of custom type",
+			src:  "3,4",
+			scan: scanInto[coordinate],
+			want: optional.Of(coordinate{X: 3, Y: 4}),
+		},
+		{
+			name: "Should scan NULL custom type as empty",
+			src:  nil,
+			scan: scanInto[coordinate],
+			want: optional.Empty[coordinate](),
+		},
+		{
+			name: "Should convert to custom type by kind",
+			src:  "active",
+			scan: scanInto[status],
+			want: optional.Of(status("active")),
+		},
+		{
+			name: "Should scan into pointer type",
+			src:  "3,4",
+			scan: scanInto[*coordinate],
+			want: optional.Of(&coordinate{X: 3, Y: 4}),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			db, _ := openFakeDB(t, tc.src)
+
+			// Act
+			got, err := tc.scan(db.QueryRow("SELECT c0"))
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func scanInto[T any](row *sql.Row) (any, error) {
+	var o optional.Optional[T]
+
+	err := row.Scan(&o)
+
+	return o, err
+}
+
+func TestOptional_Scan_bytesAreCopied(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	src := []byte("abc")
+	o := optional.Empty[[]byte]()
+
+	// Act
+	err := o.Scan(src)
+	src[0] = 'x'
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, optional.Of([]byte("abc")), o)
+}
+
+func TestOptional_Scan_error(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name string
+		scan func() (any, any, error)
+	}
+
+	tests := []test{
+		{
+			name: "Should fail on invalid conversion",
+			scan: func() (any, any, error) {
+				o := optional.Of(1)
+				err := o.Scan("abc")
+
+				return optional.Empty[int](), o, err
+			},
+		},
+		{
+			name: "Should fail on error of custom Scanner",
+			scan: func() (any, any, error) {
+				o := optional.Of(coordinate{X: 1, Y: 2})
+				err := o.Scan(int64(1))
+
+				return optional.Empty[coordinate](), o, err
+			},
+		},
+		{
+			name: "Should discard partially scanned value of custom Scanner",
+			scan: func() (any, any, error) {
+				o := optional.Of(coordinate{X: 1, Y: 2})
+				err := o.Scan("99,x")
+
+				return optional.Empty[coordinate](), o, err
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			want, got, err := tc.scan()
+
+			// Assert
+			assert.Error(t, err)
+			assert.Equal(t, want, got)
+		})
+	}
+}
+
+//nolint:funlen
+func TestOptional_Value(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2025, 3, 14, 15, 9, 26, 0, time.UTC)
+
+	type test struct {
+		name string
+		arg  any
+		want driver.Value
+	}
+
+	tests := []test{
+		{
+			name: "Should pass empty as NULL",
+			arg:  optional.Empty[int](),
+			want: nil,
+		},
+		{
+			name: "Should convert int to int64",
+			arg:  optional.Of(42),
+			want: int64(42),
+		},
+		{
+			name: "Should convert uint8 to int64",
+			arg:  optional.Of(uint8(7)),
+			want: int64(7),
+		},
+		{
+			name: "Should convert float32 to float64",
+			arg:  optional.Of(float32(1.5)),
+			want: 1.5,
+		},
+		{
+			name: "Should pass string",
+			arg:  optional.Of("abc"),
+			want: "abc",
+		},
+		{
+			name: "Should pass time.Time",
+			arg:  optional.Of(now),
+			want: now,
+		},
+		{
+			name: "Should pass []byte",
+			arg:  optional.Of([]byte{0xde, 0xad}),
+			want: []byte{0xde, 0xad},
+		},
+		{
+			name: "Should use Valuer of custom type",
+			arg:  optional.Of(coordinate{X: 3, Y: 4}),
+			want: "3,4",
+		},
+		{
+			name: "Should pass empty custom type as NULL",
+			arg:  optional.Empty[coordinate](),
+			want: nil,
+		},
+		{
+			name: "Should convert custom type by kind",
+			arg:  optional.Of(status("active")),
+			want: "active",
+		},
+		{
+			name: "Should use Valuer of sql.Null",
+			arg:  optional.Of(sql.NullString{}),
+			want: nil,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			db, fake := openFakeDB(t)
+
+			// Act
+			_, err := db.Exec("INSERT c0", tc.arg)
+
+			// Assert
+			require.NoError(t, err)
+			require.Len(t, fake.args, 1)
+			assert.Equal(t, tc.want, fake.args[0])
+		})
+	}
+}
+
+func TestOptional_Value_unsupported(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := optional.Of(struct{ A int }{A: 1})
+
+	// Act
+	_, err := o.Value()
+
+	// Assert
+	assert.Error(t, err)
+}
+
+func TestOptional_sqlRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	db, fake := openFakeDB(t)
+	want := optional.Of(coordinate{X: -1, Y: 2})
+
+	_, err := db.Exec("INSERT c0", want)
+	require.NoError(t, err)
+
+	fake.row = fake.args
+
+	// Act
+	var got optional.Optional[coordinate]
+
+	err = db.QueryRow("SELECT c0").Scan(&got)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, want, got)
+}
@@ -0,0 +1,246 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+// Bag implements a multiset, a collection of values which may be contained
+// several times. Instead of storing each occurrence, it stores the count of
+// each distinct value.
+//
+// The zero value is an empty bag. It is not thread-safe.
+type Bag[T comparable] struct {
+	counts map[T]int
+	// total is the sum of all counts.
+	total int
+}
+
+// BagOf creates a new bag with the given values, counting duplicates.
+func BagOf[T comparable](values ...T) *Bag[T] {
+	b := &Bag[T]{counts: make(map[T]int, len(values))}
+
+	for _, v := range values {
+		b.Add(v, 1)
+	}
+
+	return b
+}
+
+// BagFromSets creates a new bag, counting for each value the number of given
+// sets containing it.
+func BagFromSets[T comparable](sets ...Set[T]) *Bag[T] {
+	b := &Bag[T]{counts: createElementCounts(sets...)}
+
+	for _, count := range b.counts {
+		b.total += count
+	}
+
+	return b
+}
+
+// Add adds n occurrences of v to the bag.
+//
+// Panics if n is negative.
+func (b *Bag[T]) Add(v T, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("can't add negative count %d", n))
+	}
+
+	if n == 0 {
+		return
+	}
+
+	if b.counts == nil {
+		b.counts = make(map[T]int)
+	}
+
+	b.counts[v] += n
+	b.total += n
+}
+
+// Remove removes n occurrences of v from the bag, or all if it contains less
+// than n.
+//
+// Panics if n is negative.
+func (b *Bag[T]) Remove(v T, n int) {
+	if n < 0 {
+		panic(fmt.Sprintf("can't remove negative count %d", n))
+	}
+
+	b.setCount(v, b.counts[v]-n)
+}
+
+// Count returns the number of occurrences of v in the bag.
+func (b *Bag[T]) Count(v T) int {
+	return b.counts[v]
+}
+
+// Len returns the total number of values in the bag, counting each occurrence.
+func (b *Bag[T]) Len() int {
+	return b.total
+}
+
+// DistinctLen returns the number of distinct values in the bag.
+func (b *Bag[T]) DistinctLen() int {
+	return len(b.counts)
+}
+
+// IsEmpty returns true if the bag is empty.
+func (b *Bag[T]) IsEmpty() bool {
+	return b.total == 0
+}
+
+// Clear removes all values from the bag.
+func (b *Bag[T]) Clear() {
+	clear(b.counts)
+	b.total = 0
+}
+
+// All creates an iterator over all distinct values in the bag and their counts
+// without any particular order.
+func (b *Bag[T]) All() iter.Seq2[T, int] {
+	return func(yield func(T, int) bool) {
+		for v, count := range b.counts {
+			if !yield(v, count) {
+				return
+			}
+		}
+	}
+}
+
+// ToSet creates a [Set] with the distinct values of the bag.
+func (b *Bag[T]) ToSet() Set[T] {
+	s := WithCapacity[T](len(b.counts))
+
+	for v := range b.counts {
+		s.keySetMap[v] = placeholder
+	}
+
+	return s
+}
+
+// Clone creates a copy of the bag.
+func (b *Bag[T]) Clone() *Bag[T] {
+	return &Bag[T]{counts: maps.Clone(b.counts), total: b.total}
+}
+
+// MostCommon returns the k values with the highest counts, paired with their
+// counts, in descending order of the counts. Values with equal counts are in
+// no particular order. If the bag contains less than k distinct values, all
+// are returned.
+//
+// Panics if k is negative.
+func (b *Bag[T]) MostCommon(k int) []tuple.Pair[T, int] {
+	if k < 0 {
+		panic(fmt.Sprintf("k must not be negative, but was %d", k))
+	}
+
+	pairs := make([]tuple.Pair[T, int], 0, len(b.counts))
+	for v, count := range b.counts {
+		pairs = append(pairs, tuple.PairOf(v, count))
+	}
+
+	slices.SortFunc(pairs, func(a, b tuple.Pair[T, int]) int {
+		return cmp.Compare(b.Second(), a.Second())
+	})
+
+	return pairs[:min(k, len(pairs))]
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(Bag[{{type}}]: [{{value 1}}:{{count 1}} ...])"
+//   - If Empty: "(Bag[{{type}}]: <empty>)"
+//
+// The values are sorted by their string representation for easier overview.
+func (b *Bag[T]) String() string {
+	if b.IsEmpty() {
+		return fmt.Sprintf("(Bag[%T]: <empty>)", *new(T))
+	}
+
+	values := make([]string, 0, len(b.counts))
+	for v, count := range b.counts {
+		values = append(values, fmt.Sprintf("%v:%d", v, count))
+	}
+
+	sort.Strings(values)
+
+	return fmt.Sprintf("(Bag[%T]: %s)", *new(T), values)
+}
+
+// Union sets the count of each value to its maximum count in the bag and the
+// given bags.
+func (b *Bag[T]) Union(others ...*Bag[T]) {
+	for _, other := range others {
+		for v, count := range other.counts {
+			b.setCount(v, max(b.counts[v], count))
+		}
+	}
+}
+
+// Sum adds the counts of the values in the given bags to the bag.
+func (b *Bag[T]) Sum(others ...*Bag[T]) {
+	for _, other := range others {
+		if other == b {
+			other = other.Clone()
+		}
+
+		for v, count := range other.counts {
+			b.Add(v, count)
+		}
+	}
+}
+
+// Intersection sets the count of each value to its minimum count in the bag
+// and the given bags, removing values not contained in all bags.
+func (b *Bag[T]) Intersection(others ...*Bag[T]) {
+	for v, count := range b.counts {
+		for _, other := range others {
+			count = min(count, other.counts[v])
+		}
+
+		b.setCount(v, count)
+	}
+}
+
+// Difference subtracts the counts of the values in the given bags from the
+// bag, removing values whose count drops to zero or below.
+func (b *Bag[T]) Difference(others ...*Bag[T]) {
+	for _, other := range others {
+		if other == b {
+			b.Clear()
+
+			return
+		}
+
+		for v, count := range other.counts {
+			b.Remove(v, count)
+		}
+	}
+}
+
+// setCount sets the count of v, removing it if count is not positive.
+func (b *Bag[T]) setCount(v T, count int) {
+	previous := b.counts[v]
+
+	if count <= 0 {
+		delete(b.counts, v)
+
+		b.total -= previous
+
+		return
+	}
+
+	if b.counts == nil {
+		b.counts = make(map[T]int)
+	}
+
+	b.counts[v] = count
+	b.total += count - previous
+}
@@ -0,0 +1,193 @@
+package set_test
+
+import (
+	"fmt"
+	"maps"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+	"github.com/KrischanCS/go-toolbox/tuple"
+)
+
+func ExampleBag() {
+	words := set.BagOf(strings.Fields("the quick fox jumps over the lazy dog and the fox")...)
+
+	fmt.Println(words.Count("the"), words.Count("fox"), words.Count("cat"))
+	fmt.Println(words.Len(), words.DistinctLen())
+	fmt.Println(words.MostCommon(2))
+
+	// Output:
+	// 3 2 0
+	// 11 8
+	// [(Pair[string, int]: [the; 3]) (Pair[string, int]: [fox; 2])]
+}
+
+func ExampleBag_Union() {
+	a := set.BagOf("x", "x", "y")
+	b := set.BagOf("x", "y", "y", "z")
+
+	union := a.Clone()
+	union.Union(b)
+
+	sum := a.Clone()
+	sum.Sum(b)
+
+	intersection := a.Clone()
+	intersection.Intersection(b)
+
+	difference := a.Clone()
+	difference.Difference(b)
+
+	fmt.Println(union)
+	fmt.Println(sum)
+	fmt.Println(intersection)
+	fmt.Println(difference)
+
+	// Output:
+	// (Bag[string]: [x:2 y:2 z:1])
+	// (Bag[string]: [x:3 y:3 z:1])
+	// (Bag[string]: [x:1 y:1])
+	// (Bag[string]: [x:1])
+}
+
+func TestBag_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var b set.Bag[int]
+
+	// Assert
+	assert.True(t, b.IsEmpty())
+	assert.Equal(t, "(Bag[int]: <empty>)", b.String())
+	assert.Equal(t, 0, b.Count(1))
+	assert.Empty(t, b.MostCommon(3))
+	assert.True(t, b.ToSet().IsEmpty())
+
+	// Act
+	b.Remove(1, 2)
+	b.Add(1, 2)
+
+	// Assert
+	assert.Equal(t, 2, b.Count(1))
+	assert.Equal(t, 2, b.Len())
+}
+
+func TestBag_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := set.BagOf("a", "b", "a")
+
+	// Act
+	b.Add("a", 3)
+	b.Add("c", 0)
+	b.Remove("b", 5)
+	b.Remove("a", 1)
+	b.Remove("d", 1)
+
+	// Assert
+	assert.Equal(t, map[string]int{"a": 4}, maps.Collect(b.All()))
+	assert.Equal(t, 4, b.Len())
+	assert.Equal(t, 1, b.DistinctLen())
+	assert.Panics(t, func() { b.Add("a", -1) })
+	assert.Panics(t, func() { b.Remove("a", -1) })
+	assert.Panics(t, func() { b.MostCommon(-1) })
+
+	b.Clear()
+	assert.True(t, b.IsEmpty())
+	assert.Equal(t, 0, b.DistinctLen())
+}
+
+//nolint:funlen
+func TestBag_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(b *set.Bag[string], others ...*set.Bag[string])
+		expect    map[string]int
+	}
+
+	tests := []test{
+		{"union", (*set.Bag[string]).Union, map[string]int{"a": 3, "b": 2, "c": 4, "d": 1}},
+		{"sum", (*set.Bag[string]).Sum, map[string]int{"a": 4, "b": 3, "c": 6, "d": 1}},
+		{"intersection", (*set.Bag[string]).Intersection, map[string]int{"c": 1}},
+		{"difference", (*set.Bag[string]).Difference, map[string]int{"a": 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := set.BagOf("a", "a", "a", "b", "c")
+			others := []*set.Bag[string]{
+				set.BagOf("a", "b", "b", "c", "c", "c", "c"),
+				set.BagOf("c", "d"),
+			}
+
+			// Act
+			tc.operation(b, others...)
+
+			// Assert
+			assert.Equal(t, tc.expect, maps.Collect(b.All()))
+
+			total := 0
+			for _, count := range tc.expect {
+				total += count
+			}
+
+			assert.Equal(t, total, b.Len())
+			assert.Equal(t, 7, others[0].Len(), "others must not be modified")
+		})
+	}
+}
+
+func TestBag_OperationsWithItself(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := set.BagOf(1, 1, 2)
+
+	// Act & Assert
+	b.Union(b)
+	assert.Equal(t, map[int]int{1: 2, 2: 1}, maps.Collect(b.All()))
+
+	b.Intersection(b)
+	assert.Equal(t, map[int]int{1: 2, 2: 1}, maps.Collect(b.All()))
+
+	b.Sum(b)
+	assert.Equal(t, map[int]int{1: 4, 2: 2}, maps.Collect(b.All()))
+	assert.Equal(t, 6, b.Len())
+
+	b.Difference(b)
+	assert.True(t, b.IsEmpty())
+}
+
+func TestBag_sets(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	b := set.BagFromSets(set.Of(1, 2, 3), set.Of(2, 3), set.Of(3))
+
+	// Assert
+	assert.Equal(t, map[int]int{1: 1, 2: 2, 3: 3}, maps.Collect(b.All()))
+	assert.Equal(t, 6, b.Len())
+	assert.True(t, b.ToSet().Equal(set.Of(1, 2, 3)))
+	assert.True(t, set.BagFromSets[int]().IsEmpty())
+}
+
+func TestBag_MostCommon(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := set.BagOf('a', 'b', 'b', 'c', 'c', 'c')
+
+	// Assert
+	assert.Equal(t, []tuple.Pair[rune, int]{tuple.PairOf('c', 3), tuple.PairOf('b', 2)}, b.MostCommon(2))
+	assert.Len(t, b.MostCommon(10), 3)
+	assert.Empty(t, b.MostCommon(0))
+}
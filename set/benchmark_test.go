@@ -2,6 +2,7 @@ package set_test
 
 import (
 	"math/rand"
+	"sync"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -142,3 +143,396 @@ func benchOpOf(b *testing.B, fn func(sets ...set.Set[string]) set.Set[string]) {
 		_ = fn(chosenSets...)
 	}
 }
+
+// mutexSet is the naive thread-safe set, the [set.Concurrent] is compared to.
+type mutexSet struct {
+	sync.RWMutex
+
+	set set.Set[int]
+}
+
+const parallelBenchmarkValues = 1 << 16
+
+func BenchmarkConcurrent_AddIfAbsent_parallel(b *testing.B) {
+	b.ReportAllocs()
+
+	s := set.NewConcurrent[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.AddIfAbsent(i % parallelBenchmarkValues)
+			i++
+		}
+	})
+}
+
+func BenchmarkMutexSet_Add_parallel(b *testing.B) {
+	b.ReportAllocs()
+
+	s := mutexSet{set: set.Of[int]()}
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			s.Lock()
+			s.set.Add(i % parallelBenchmarkValues)
+			s.Unlock()
+			i++
+		}
+	})
+}
+
+func BenchmarkConcurrent_Mixed_parallel(b *testing.B) {
+	b.ReportAllocs()
+
+	s := set.NewConcurrent[int]()
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			v := i % parallelBenchmarkValues
+
+			switch i % 4 {
+			case 0:
+				s.AddIfAbsent(v)
+			case 1:
+				s.RemoveIfPresent(v)
+			default:
+				s.Contains(v)
+			}
+
+			i++
+		}
+	})
+}
+
+func BenchmarkMutexSet_Mixed_parallel(b *testing.B) {
+	b.ReportAllocs()
+
+	s := mutexSet{set: set.Of[int]()}
+
+	b.RunParallel(func(pb *testing.PB) {
+		i := 0
+		for pb.Next() {
+			v := i % parallelBenchmarkValues
+
+			switch i % 4 {
+			case 0:
+				s.Lock()
+				s.set.Add(v)
+				s.Unlock()
+			case 1:
+				s.Lock()
+				s.set.Remove(v)
+				s.Unlock()
+			default:
+				s.RLock()
+				s.set.Contains(v)
+				s.RUnlock()
+			}
+
+			i++
+		}
+	})
+}
+
+func BenchmarkOrderedSet_Add(b *testing.B) {
+	b.ReportAllocs()
+
+	const (
+		numAdds            = 1500
+		numDifferentValues = 333
+	)
+
+	values := make([]int, numAdds)
+	for i := range numAdds {
+		values[i] = i % numDifferentValues
+	}
+
+	var s set.OrderedSet[int]
+	for b.Loop() {
+		s = set.OrderedOf[int]()
+
+		for _, v := range values {
+			s.Add(v)
+		}
+	}
+
+	assert.Equal(b, numDifferentValues, s.Len())
+}
+
+func BenchmarkOrderedSet_Select(b *testing.B) {
+	b.ReportAllocs()
+
+	const numValues = 100_000
+
+	s := set.OrderedOf[int]()
+	for i := range numValues {
+		s.Add(i)
+	}
+
+	i := 0
+	for b.Loop() {
+		_ = s.Select(i % numValues)
+		i += 7919
+	}
+}
+
+func BenchmarkUnionSeq(b *testing.B) {
+	benchOpOf(b, func(sets ...set.Set[string]) set.Set[string] {
+		for range set.UnionSeq(sets...) {
+		}
+
+		return set.Set[string]{}
+	})
+}
+
+func BenchmarkIntersectionSeq(b *testing.B) {
+	benchOpOf(b, func(sets ...set.Set[string]) set.Set[string] {
+		for range set.IntersectionSeq(sets...) {
+		}
+
+		return set.Set[string]{}
+	})
+}
+
+func BenchmarkDifferenceSeq(b *testing.B) {
+	benchOpOf(b, func(sets ...set.Set[string]) set.Set[string] {
+		for range set.DifferenceSeq(sets...) {
+		}
+
+		return set.Set[string]{}
+	})
+}
+
+const snapshotBenchmarkValues = 10_000
+
+func BenchmarkImmutable_snapshot(b *testing.B) {
+	b.ReportAllocs()
+
+	s := set.ImmutableFrom(func(yield func(int) bool) {
+		for i := range snapshotBenchmarkValues {
+			if !yield(i) {
+				return
+			}
+		}
+	})
+
+	i := 0
+	for b.Loop() {
+		s = s.Add(snapshotBenchmarkValues + i).Remove(i)
+		i++
+	}
+
+	assert.Equal(b, snapshotBenchmarkValues, s.Len())
+}
+
+func BenchmarkSet_snapshotByClone(b *testing.B) {
+	b.ReportAllocs()
+
+	s := set.WithCapacity[int](snapshotBenchmarkValues)
+	for i := range snapshotBenchmarkValues {
+		s.Add(i)
+	}
+
+	i := 0
+	for b.Loop() {
+		s = s.Clone()
+		s.Add(snapshotBenchmarkValues + i)
+		s.Remove(i)
+		i++
+	}
+
+	assert.Equal(b, snapshotBenchmarkValues, s.Len())
+}
+
+func BenchmarkImmutableBuilder_Add(b *testing.B) {
+	b.ReportAllocs()
+
+	var s set.Immutable[int]
+	for b.Loop() {
+		var builder set.ImmutableBuilder[int]
+
+		for i := range snapshotBenchmarkValues {
+			builder.Add(i)
+		}
+
+		s = builder.Immutable()
+	}
+
+	assert.Equal(b, snapshotBenchmarkValues, s.Len())
+}
+
+const bitSetBenchmarkValues = 1 << 16
+
+func BenchmarkBitSet_Add(b *testing.B) {
+	b.ReportAllocs()
+
+	var s *set.BitSet[int]
+	for b.Loop() {
+		s = set.BitSetOf[int]()
+
+		for i := range bitSetBenchmarkValues {
+			s.Add(i * 3)
+		}
+	}
+
+	assert.Equal(b, bitSetBenchmarkValues, s.Len())
+}
+
+func BenchmarkSet_Add_dense(b *testing.B) {
+	b.ReportAllocs()
+
+	var s set.Set[int]
+	for b.Loop() {
+		s = set.Of[int]()
+
+		for i := range bitSetBenchmarkValues {
+			s.Add(i * 3)
+		}
+	}
+
+	assert.Equal(b, bitSetBenchmarkValues, s.Len())
+}
+
+func BenchmarkBitSet_Contains(b *testing.B) {
+	s := set.BitSetOf[int]()
+	for i := range bitSetBenchmarkValues {
+		s.Add(i * 3)
+	}
+
+	i := 0
+	for b.Loop() {
+		_ = s.Contains(i % (3 * bitSetBenchmarkValues))
+		i += 7919
+	}
+}
+
+func BenchmarkSet_Contains_dense(b *testing.B) {
+	s := set.Of[int]()
+	for i := range bitSetBenchmarkValues {
+		s.Add(i * 3)
+	}
+
+	i := 0
+	for b.Loop() {
+		_ = s.Contains(i % (3 * bitSetBenchmarkValues))
+		i += 7919
+	}
+}
+
+func BenchmarkBitSet_Union(b *testing.B) {
+	b.ReportAllocs()
+
+	evens, odds := set.BitSetOf[int](), set.BitSetOf[int]()
+	for i := range bitSetBenchmarkValues {
+		evens.Add(2 * i)
+		odds.Add(2*i + 1)
+	}
+
+	for b.Loop() {
+		s := evens.Clone()
+		s.Union(odds)
+	}
+}
+
+func BenchmarkSet_Union_dense(b *testing.B) {
+	b.ReportAllocs()
+
+	evens, odds := set.Of[int](), set.Of[int]()
+	for i := range bitSetBenchmarkValues {
+		evens.Add(2 * i)
+		odds.Add(2*i + 1)
+	}
+
+	for b.Loop() {
+		s := evens.Clone()
+		s.Union(odds)
+	}
+}
+
+func BenchmarkBitSet_Intersection(b *testing.B) {
+	b.ReportAllocs()
+
+	multiplesOf2, multiplesOf3 := set.BitSetOf[int](), set.BitSetOf[int]()
+	for i := range bitSetBenchmarkValues {
+		multiplesOf2.Add(2 * i)
+		multiplesOf3.Add(3 * i)
+	}
+
+	for b.Loop() {
+		s := multiplesOf2.Clone()
+		s.Intersection(multiplesOf3)
+	}
+}
+
+func BenchmarkSet_Intersection_dense(b *testing.B) {
+	b.ReportAllocs()
+
+	multiplesOf2, multiplesOf3 := set.Of[int](), set.Of[int]()
+	for i := range bitSetBenchmarkValues {
+		multiplesOf2.Add(2 * i)
+		multiplesOf3.Add(3 * i)
+	}
+
+	for b.Loop() {
+		s := multiplesOf2.Clone()
+		s.Intersection(multiplesOf3)
+	}
+}
+
+const disjointSetBenchmarkValues = 100_000
+
+func BenchmarkDisjointSet_Union(b *testing.B) {
+	b.ReportAllocs()
+
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(1))
+
+	pairs := make([][2]int, disjointSetBenchmarkValues)
+	for i := range pairs {
+		pairs[i] = [2]int{rand.Intn(disjointSetBenchmarkValues), rand.Intn(disjointSetBenchmarkValues)}
+	}
+
+	var d *set.DisjointSet[int]
+	for b.Loop() {
+		d = set.NewDisjointSet[int]()
+
+		for _, pair := range pairs {
+			d.Union(pair[0], pair[1])
+		}
+	}
+
+	assert.Positive(b, d.ComponentCount())
+}
+
+func BenchmarkDisjointSet_Connected(b *testing.B) {
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(1))
+
+	d := set.NewDisjointSet[int]()
+	for range disjointSetBenchmarkValues {
+		d.Union(rand.Intn(disjointSetBenchmarkValues), rand.Intn(disjointSetBenchmarkValues))
+	}
+
+	i := 0
+	for b.Loop() {
+		_ = d.Connected(i%disjointSetBenchmarkValues, (i*7919)%disjointSetBenchmarkValues)
+		i++
+	}
+}
+
+func BenchmarkDisjointSet_Components(b *testing.B) {
+	b.ReportAllocs()
+
+	d := set.NewDisjointSet[int]()
+	for i := range disjointSetBenchmarkValues {
+		d.Union(i, i%1000)
+	}
+
+	for b.Loop() {
+		for range d.Components() {
+		}
+	}
+}
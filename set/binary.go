@@ -0,0 +1,45 @@
+package set
+
+import (
+	"bytes"
+	"encoding/gob"
+)
+
+// MarshalBinary encodes the values of the set with encoding/gob in no
+// particular order, so the set can be restored with [Set.UnmarshalBinary]. It
+// also makes a Set encodable as part of other values by encoding/gob.
+func (s Set[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.Values())
+}
+
+// UnmarshalBinary decodes data created by [Set.MarshalBinary] into the set,
+// replacing its previous values. Duplicate values are merged, like in [Of].
+func (s *Set[T]) UnmarshalBinary(data []byte) error {
+	var values []T
+
+	err := gob.NewDecoder(bytes.NewReader(data)).Decode(&values)
+	if err != nil {
+		return err
+	}
+
+	*s = Of(values...)
+
+	return nil
+}
+
+// MarshalBinary encodes the values of the set like [Set.MarshalBinary], but in
+// ascending order, so equal sets result in equal data.
+func (s Sorted[T]) MarshalBinary() ([]byte, error) {
+	return encodeGob(s.sortedValues())
+}
+
+func encodeGob[T any](values []T) ([]byte, error) {
+	var buf bytes.Buffer
+
+	err := gob.NewEncoder(&buf).Encode(values)
+	if err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,84 @@
+package set_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func TestSet_Binary(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of(point{1, 2}, point{3, 4})
+
+	// Act
+	data, err := s.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded set.Set[point]
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, s, decoded)
+}
+
+func TestSet_Binary_invalid(t *testing.T) {
+	t.Parallel()
+
+	var s set.Set[int]
+
+	assert.Error(t, s.UnmarshalBinary([]byte("no gob")))
+}
+
+func TestSorted_MarshalBinary_deterministic(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = i * 7
+	}
+
+	// Act
+	first, err := set.Sorted[int]{set.Of(values...)}.MarshalBinary()
+	require.NoError(t, err)
+
+	second, err := set.Sorted[int]{set.Of(values...)}.MarshalBinary()
+	require.NoError(t, err)
+
+	// Assert
+	assert.Equal(t, first, second)
+}
+
+func TestSet_Gob_inStruct(t *testing.T) {
+	t.Parallel()
+
+	type team struct {
+		Name    string
+		Members set.Set[string]
+		Admins  set.Sorted[string]
+	}
+
+	// Arrange
+	original := team{Name: "core", Members: set.Of("ada", "bob", "eve"), Admins: set.Sorted[string]{set.Of("ada")}}
+
+	var buf bytes.Buffer
+
+	// Act
+	err := gob.NewEncoder(&buf).Encode(original)
+	require.NoError(t, err)
+
+	var decoded team
+	err = gob.NewDecoder(&buf).Decode(&decoded)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, original, decoded)
+}
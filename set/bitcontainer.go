@@ -0,0 +1,341 @@
+package set
+
+import (
+	"math/bits"
+	"slices"
+)
+
+const (
+	// containerBits is the number of low bits of a value stored in a container,
+	// the remaining high bits are the key of the container.
+	containerBits = 16
+	// containerWords is the number of words of a dense container.
+	containerWords = 1 << containerBits / 64
+	// maxSparseLen is the maximum number of values of a sparse container. Above
+	// it, a dense container needs less memory.
+	maxSparseLen = 4096
+)
+
+// bitContainer holds all values of a [BitSet] sharing the same high bits as
+// described for Roaring bitmaps by Chambi, Lemire et al.
+//
+// Sparse containers store the low bits of their values as sorted slice, dense
+// containers as bitmap. A container is sparse if and only if it holds at most
+// maxSparseLen values, so the representation only depends on the values. Run
+// containers of Roaring bitmaps are not implemented.
+type bitContainer struct {
+	key uint64
+	// values holds the sorted low bits of sparse containers.
+	values []uint16
+	// words holds the bitmap of dense containers, nil for sparse containers.
+	words []uint64
+	// count is the number of values in the container.
+	count int
+}
+
+func newDenseWords() []uint64 {
+	return make([]uint64, containerWords)
+}
+
+func (c *bitContainer) isDense() bool {
+	return c.words != nil
+}
+
+func (c *bitContainer) contains(low uint16) bool {
+	if c.isDense() {
+		return c.words[low/64]&(1<<(low%64)) != 0
+	}
+
+	_, found := slices.BinarySearch(c.values, low)
+
+	return found
+}
+
+// add adds low to the container and returns true, if it was not contained.
+func (c *bitContainer) add(low uint16) bool {
+	if c.isDense() {
+		word, bit := &c.words[low/64], uint64(1)<<(low%64)
+		if *word&bit != 0 {
+			return false
+		}
+
+		*word |= bit
+		c.count++
+
+		return true
+	}
+
+	i, found := slices.BinarySearch(c.values, low)
+	if found {
+		return false
+	}
+
+	c.values = slices.Insert(c.values, i, low)
+	c.count++
+
+	if c.count > maxSparseLen {
+		c.setWords(c.denseWords())
+	}
+
+	return true
+}
+
+// remove removes low from the container and returns true, if it was contained.
+func (c *bitContainer) remove(low uint16) bool {
+	if c.isDense() {
+		word, bit := &c.words[low/64], uint64(1)<<(low%64)
+		if *word&bit == 0 {
+			return false
+		}
+
+		*word &^= bit
+		c.count--
+
+		if c.count <= maxSparseLen {
+			c.setWords(c.words)
+		}
+
+		return true
+	}
+
+	i, found := slices.BinarySearch(c.values, low)
+	if !found {
+		return false
+	}
+
+	c.values = slices.Delete(c.values, i, i+1)
+	c.count--
+
+	return true
+}
+
+// next returns the smallest value >= low and true, or false if there is none.
+func (c *bitContainer) next(low uint16) (uint16, bool) {
+	if !c.isDense() {
+		i, _ := slices.BinarySearch(c.values, low)
+		if i == len(c.values) {
+			return 0, false
+		}
+
+		return c.values[i], true
+	}
+
+	i := int(low / 64)
+
+	word := c.words[i] & (^uint64(0) << (low % 64))
+	for word == 0 {
+		i++
+		if i == containerWords {
+			return 0, false
+		}
+
+		word = c.words[i]
+	}
+
+	return uint16(i*64 + bits.TrailingZeros64(word)), true //nolint:gosec // at most 1<<16-1
+}
+
+// prev returns the largest value <= low and true, or false if there is none.
+func (c *bitContainer) prev(low uint16) (uint16, bool) {
+	if !c.isDense() {
+		i, found := slices.BinarySearch(c.values, low)
+		if found {
+			return low, true
+		}
+
+		if i == 0 {
+			return 0, false
+		}
+
+		return c.values[i-1], true
+	}
+
+	i := int(low / 64)
+
+	word := c.words[i] & (^uint64(0) >> (63 - low%64))
+	for word == 0 {
+		i--
+		if i < 0 {
+			return 0, false
+		}
+
+		word = c.words[i]
+	}
+
+	return uint16(i*64 + 63 - bits.LeadingZeros64(word)), true //nolint:gosec // at most 1<<16-1
+}
+
+// all yields the low bits of all values in ascending order and returns false,
+// if yield returned false.
+func (c *bitContainer) all(yield func(uint16) bool) bool {
+	if !c.isDense() {
+		for _, low := range c.values {
+			if !yield(low) {
+				return false
+			}
+		}
+
+		return true
+	}
+
+	for i, word := range c.words {
+		for word != 0 {
+			low := uint16(i*64 + bits.TrailingZeros64(word)) //nolint:gosec // at most 1<<16-1
+			if !yield(low) {
+				return false
+			}
+
+			// Clear the lowest set bit.
+			word &= word - 1
+		}
+	}
+
+	return true
+}
+
+// denseWords returns a new bitmap with the values of the container.
+func (c *bitContainer) denseWords() []uint64 {
+	words := newDenseWords()
+
+	if c.isDense() {
+		copy(words, c.words)
+
+		return words
+	}
+
+	for _, low := range c.values {
+		words[low/64] |= 1 << (low % 64)
+	}
+
+	return words
+}
+
+// setWords sets the values of the container to the bits of words, counting
+// them word by word and choosing the representation by the count. words may
+// be reused by the container.
+func (c *bitContainer) setWords(words []uint64) {
+	count := 0
+	for _, word := range words {
+		count += bits.OnesCount64(word)
+	}
+
+	c.count = count
+
+	if count > maxSparseLen {
+		c.words, c.values = words, nil
+
+		return
+	}
+
+	values := make([]uint16, 0, count)
+
+	for i, word := range words {
+		for word != 0 {
+			values = append(values, uint16(i*64+bits.TrailingZeros64(word))) //nolint:gosec // at most 1<<16-1
+			word &= word - 1
+		}
+	}
+
+	c.words, c.values = nil, values
+}
+
+func (c *bitContainer) clone() bitContainer {
+	return bitContainer{
+		key:    c.key,
+		values: slices.Clone(c.values),
+		words:  slices.Clone(c.words),
+		count:  c.count,
+	}
+}
+
+// union adds all values of other to c.
+func (c *bitContainer) union(other *bitContainer) {
+	switch {
+	case !c.isDense() && !other.isDense() && c.count+other.count <= maxSparseLen:
+		c.values = mergeSorted(c.values, other.values)
+		c.count = len(c.values)
+	case other.isDense():
+		words := c.denseWords()
+		for i, word := range other.words {
+			words[i] |= word
+		}
+
+		c.setWords(words)
+	default:
+		words := c.denseWords()
+		for _, low := range other.values {
+			words[low/64] |= 1 << (low % 64)
+		}
+
+		c.setWords(words)
+	}
+}
+
+// intersect removes all values of c not contained in other.
+func (c *bitContainer) intersect(other *bitContainer) {
+	switch {
+	case c.isDense() && other.isDense():
+		for i, word := range other.words {
+			c.words[i] &= word
+		}
+
+		c.setWords(c.words)
+	case c.isDense():
+		values := make([]uint16, 0, other.count)
+		for _, low := range other.values {
+			if c.contains(low) {
+				values = append(values, low)
+			}
+		}
+
+		c.words, c.values, c.count = nil, values, len(values)
+	default:
+		c.values = slices.DeleteFunc(c.values, func(low uint16) bool { return !other.contains(low) })
+		c.count = len(c.values)
+	}
+}
+
+// subtract removes all values of other from c.
+func (c *bitContainer) subtract(other *bitContainer) {
+	switch {
+	case c.isDense() && other.isDense():
+		for i, word := range other.words {
+			c.words[i] &^= word
+		}
+
+		c.setWords(c.words)
+	case c.isDense():
+		for _, low := range other.values {
+			c.words[low/64] &^= 1 << (low % 64)
+		}
+
+		c.setWords(c.words)
+	default:
+		c.values = slices.DeleteFunc(c.values, other.contains)
+		c.count = len(c.values)
+	}
+}
+
+// mergeSorted returns a new sorted slice with the values of a and b without
+// duplicates.
+func mergeSorted(a, b []uint16) []uint16 {
+	merged := make([]uint16, 0, len(a)+len(b))
+
+	for len(a) > 0 && len(b) > 0 {
+		switch {
+		case a[0] < b[0]:
+			merged = append(merged, a[0])
+			a = a[1:]
+		case a[0] > b[0]:
+			merged = append(merged, b[0])
+			b = b[1:]
+		default:
+			merged = append(merged, a[0])
+			a, b = a[1:], b[1:]
+		}
+	}
+
+	merged = append(merged, a...)
+
+	return append(merged, b...)
+}
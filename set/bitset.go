@@ -0,0 +1,389 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"slices"
+
+	"github.com/KrischanCS/go-toolbox/constraints"
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// BitSet implements a collection of unique, non-negative integers, which is
+// much more compact and faster than a [Set] for dense values, like feature
+// flags, permissions or IDs from a small range.
+//
+// The values are split into containers of 2^16 consecutive values, like in
+// Roaring bitmaps. Containers with few values store them as sorted slice, all
+// others as bitmap of 64 bit words. Thus memory usage stays low for sparse
+// values, while operations on dense values, like [BitSet.Union], process 64
+// values at once. Empty ranges take no memory at all.
+//
+// Unlike full Roaring bitmaps, there are no run containers: long runs of
+// consecutive values are stored as bitmaps, taking 8 KiB per container instead
+// of a few bytes.
+//
+// The values are iterated in ascending order. Adding negative values panics.
+//
+// The zero value is an empty set. It is not thread-safe.
+type BitSet[T constraints.Integer] struct {
+	// containers holds the non-empty containers sorted by key.
+	containers []bitContainer
+}
+
+// BitSetOf creates a new bit set with the given values.
+//
+// Panics if any value is negative.
+func BitSetOf[T constraints.Integer](values ...T) *BitSet[T] {
+	b := &BitSet[T]{}
+
+	b.Add(values...)
+
+	return b
+}
+
+// Add adds the given values to the set if they are not already present.
+//
+// Panics if any value is negative.
+func (b *BitSet[T]) Add(values ...T) {
+	for _, v := range values {
+		if v < 0 {
+			panic(fmt.Sprintf("BitSet can't hold negative value %d", v))
+		}
+
+		key, low := splitBits(v)
+
+		i, found := b.search(key)
+		if !found {
+			b.containers = slices.Insert(b.containers, i, bitContainer{key: key})
+		}
+
+		b.containers[i].add(low)
+	}
+}
+
+// Remove removes the given values from the set.
+func (b *BitSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		if v < 0 {
+			continue
+		}
+
+		key, low := splitBits(v)
+
+		i, found := b.search(key)
+		if !found {
+			continue
+		}
+
+		b.containers[i].remove(low)
+
+		if b.containers[i].count == 0 {
+			b.containers = slices.Delete(b.containers, i, i+1)
+		}
+	}
+}
+
+// Clear removes all values from the set.
+func (b *BitSet[T]) Clear() {
+	b.containers = nil
+}
+
+// Contains checks if the set contains all the given values.
+func (b *BitSet[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		if v < 0 {
+			return false
+		}
+
+		key, low := splitBits(v)
+
+		i, found := b.search(key)
+		if !found || !b.containers[i].contains(low) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values in the set.
+func (b *BitSet[T]) Len() int {
+	length := 0
+	for i := range b.containers {
+		length += b.containers[i].count
+	}
+
+	return length
+}
+
+// IsEmpty returns true if the set is empty.
+func (b *BitSet[T]) IsEmpty() bool {
+	return len(b.containers) == 0
+}
+
+// Values returns a slice of all values in the set in ascending order.
+func (b *BitSet[T]) Values() []T {
+	values := make([]T, 0, b.Len())
+
+	for v := range b.All() {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// All creates an iterator over all values in the set in ascending order.
+func (b *BitSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i := range b.containers {
+			key := b.containers[i].key
+
+			if !b.containers[i].all(func(low uint16) bool { return yield(joinBits[T](key, low)) }) {
+				return
+			}
+		}
+	}
+}
+
+// NextSet returns the smallest value in the set greater than or equal to from,
+// or an empty optional if there is none.
+func (b *BitSet[T]) NextSet(from T) optional.Optional[T] {
+	from = max(from, 0)
+	key, low := splitBits(from)
+
+	i, found := b.search(key)
+	if found {
+		if next, ok := b.containers[i].next(low); ok {
+			return optional.Of(joinBits[T](key, next))
+		}
+
+		i++
+	}
+
+	if i == len(b.containers) {
+		return optional.Empty[T]()
+	}
+
+	// Containers are never empty.
+	next, _ := b.containers[i].next(0)
+
+	return optional.Of(joinBits[T](b.containers[i].key, next))
+}
+
+// PrevSet returns the largest value in the set less than or equal to from, or
+// an empty optional if there is none.
+func (b *BitSet[T]) PrevSet(from T) optional.Optional[T] {
+	if from < 0 {
+		return optional.Empty[T]()
+	}
+
+	key, low := splitBits(from)
+
+	i, found := b.search(key)
+	if found {
+		if prev, ok := b.containers[i].prev(low); ok {
+			return optional.Of(joinBits[T](key, prev))
+		}
+	}
+
+	if i == 0 {
+		return optional.Empty[T]()
+	}
+
+	// Containers are never empty.
+	prev, _ := b.containers[i-1].prev(1<<containerBits - 1)
+
+	return optional.Of(joinBits[T](b.containers[i-1].key, prev))
+}
+
+// Clone creates a copy of the set.
+func (b *BitSet[T]) Clone() *BitSet[T] {
+	clone := &BitSet[T]{containers: make([]bitContainer, len(b.containers))}
+
+	for i := range b.containers {
+		clone.containers[i] = b.containers[i].clone()
+	}
+
+	return clone
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(BitSet[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(BitSet[{{type}}]: <empty>)"
+//
+// The values are printed in ascending order.
+func (b *BitSet[T]) String() string {
+	if b.IsEmpty() {
+		return fmt.Sprintf("(BitSet[%T]: <empty>)", *new(T))
+	}
+
+	return fmt.Sprintf("(BitSet[%T]: %v)", *new(T), b.Values())
+}
+
+// Union adds all values from the given sets to the current set.
+func (b *BitSet[T]) Union(others ...*BitSet[T]) {
+	for _, other := range others {
+		if other == b {
+			continue
+		}
+
+		for j := range other.containers {
+			c := &other.containers[j]
+
+			i, found := b.search(c.key)
+			if !found {
+				b.containers = slices.Insert(b.containers, i, c.clone())
+
+				continue
+			}
+
+			b.containers[i].union(c)
+		}
+	}
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets.
+func (b *BitSet[T]) Intersection(others ...*BitSet[T]) {
+	for _, other := range others {
+		if other == b {
+			continue
+		}
+
+		b.keepContainers(func(c *bitContainer) bool {
+			j, found := other.search(c.key)
+			if !found {
+				return false
+			}
+
+			c.intersect(&other.containers[j])
+
+			return c.count > 0
+		})
+	}
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets.
+func (b *BitSet[T]) Difference(others ...*BitSet[T]) {
+	for _, other := range others {
+		if other == b {
+			b.Clear()
+
+			return
+		}
+
+		b.keepContainers(func(c *bitContainer) bool {
+			j, found := other.search(c.key)
+			if !found {
+				return true
+			}
+
+			c.subtract(&other.containers[j])
+
+			return c.count > 0
+		})
+	}
+}
+
+// Unique modifies the set, to only contain values which appear only in one set,
+// including the set itself and all given other sets.
+func (b *BitSet[T]) Unique(others ...*BitSet[T]) {
+	if len(others) == 0 {
+		return
+	}
+
+	sets := append([]*BitSet[T]{b}, others...)
+
+	var keys []uint64
+	for _, s := range sets {
+		for i := range s.containers {
+			keys = append(keys, s.containers[i].key)
+		}
+	}
+
+	slices.Sort(keys)
+
+	containers := make([]bitContainer, 0, len(b.containers))
+
+	for _, key := range slices.Compact(keys) {
+		// once holds the values seen an odd number of times so far, more the
+		// ones seen at least twice. Removing more from once leaves the values
+		// contained in exactly one set.
+		once, more := newDenseWords(), newDenseWords()
+
+		for _, s := range sets {
+			if i, found := s.search(key); found {
+				countBits(&s.containers[i], once, more)
+			}
+		}
+
+		for i := range once {
+			once[i] &^= more[i]
+		}
+
+		c := bitContainer{key: key}
+		c.setWords(once)
+
+		if c.count > 0 {
+			containers = append(containers, c)
+		}
+	}
+
+	b.containers = containers
+}
+
+// keepContainers removes all containers, for which keep returns false. keep
+// may modify the containers.
+func (b *BitSet[T]) keepContainers(keep func(c *bitContainer) bool) {
+	kept := b.containers[:0]
+
+	for i := range b.containers {
+		if keep(&b.containers[i]) {
+			kept = append(kept, b.containers[i])
+		}
+	}
+
+	clear(b.containers[len(kept):])
+	b.containers = kept
+}
+
+// countBits adds the values of c to the bit counts once and more.
+func countBits(c *bitContainer, once, more []uint64) {
+	if c.isDense() {
+		for i, word := range c.words {
+			more[i] |= once[i] & word
+			once[i] ^= word
+		}
+
+		return
+	}
+
+	for _, low := range c.values {
+		i, bit := low/64, uint64(1)<<(low%64)
+		more[i] |= once[i] & bit
+		once[i] ^= bit
+	}
+}
+
+// search returns the index of the container with key and true, or the index
+// to insert it and false.
+func (b *BitSet[T]) search(key uint64) (int, bool) {
+	return slices.BinarySearchFunc(b.containers, key, func(c bitContainer, key uint64) int {
+		return cmp.Compare(c.key, key)
+	})
+}
+
+// splitBits splits the non-negative v into the key of its container and its
+// low bits.
+func splitBits[T constraints.Integer](v T) (uint64, uint16) {
+	u := uint64(v) //nolint:gosec // v is non-negative
+
+	return u >> containerBits, uint16(u) //nolint:gosec // truncation intended
+}
+
+func joinBits[T constraints.Integer](key uint64, low uint16) T {
+	return T(key<<containerBits | uint64(low)) //nolint:gosec // inverse of splitBits
+}
@@ -0,0 +1,270 @@
+package set_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleBitSet() {
+	type permission uint8
+
+	const (
+		read permission = iota
+		write
+		execute
+		admin
+	)
+
+	granted := set.BitSetOf(read, write)
+	required := set.BitSetOf(read, execute)
+
+	missing := required.Clone()
+	missing.Difference(granted)
+
+	fmt.Println(granted.Contains(read, write), granted.Contains(admin))
+	fmt.Println(missing)
+
+	// Output:
+	// true false
+	// (BitSet[set_test.permission]: [2])
+}
+
+func ExampleBitSet_NextSet() {
+	b := set.BitSetOf(3, 70, 100_000)
+
+	for v, ok := b.NextSet(0).Get(); ok; v, ok = b.NextSet(v + 1).Get() {
+		fmt.Println(v)
+	}
+
+	fmt.Println(b.PrevSet(99_999))
+
+	// Output:
+	// 3
+	// 70
+	// 100000
+	// (Optional[int]: 70)
+}
+
+func TestBitSet_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var b set.BitSet[uint64]
+
+	// Assert
+	assert.True(t, b.IsEmpty())
+	assert.Equal(t, 0, b.Len())
+	assert.Equal(t, "(BitSet[uint64]: <empty>)", b.String())
+	assert.Empty(t, b.Values())
+	assert.False(t, b.Contains(0))
+	assert.Equal(t, optional.Empty[uint64](), b.NextSet(0))
+	assert.Equal(t, optional.Empty[uint64](), b.PrevSet(1<<63))
+
+	// Act
+	b.Add(1<<64 - 1)
+
+	// Assert
+	assert.Equal(t, []uint64{1<<64 - 1}, b.Values())
+}
+
+func TestBitSet_negative(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := set.BitSetOf(1, 2)
+
+	// Act
+	b.Remove(-1)
+
+	// Assert
+	assert.Panics(t, func() { b.Add(-1) })
+	assert.False(t, b.Contains(-1))
+	assert.Equal(t, optional.Of(1), b.NextSet(-5))
+	assert.Equal(t, optional.Empty[int](), b.PrevSet(-1))
+	assert.Equal(t, 2, b.Len())
+}
+
+// randomBitSetValues creates values, which are dense in the first containers
+// and sparse in the following ones.
+func randomBitSetValues(rand *rand.Rand, n int) []int {
+	values := make([]int, n)
+
+	for i := range values {
+		switch rand.Intn(4) {
+		case 0:
+			values[i] = rand.Intn(1 << 40)
+		case 1:
+			values[i] = 1<<16 + rand.Intn(1<<16)
+		default:
+			values[i] = rand.Intn(1 << 13)
+		}
+	}
+
+	return values
+}
+
+//nolint:funlen
+func TestBitSet_randomOperations(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(7))
+
+	b := set.BitSetOf[int]()
+	reference := set.Of[int]()
+
+	values := randomBitSetValues(rand, 40_000)
+
+	for i, v := range values {
+		// Act
+		// Removing more values in the second half crosses the threshold
+		// between sparse and dense containers in both directions.
+		if rand.Intn(4) == 0 || i > len(values)/2 && rand.Intn(3) > 0 {
+			b.Remove(v)
+			reference.Remove(v)
+		} else {
+			b.Add(v)
+			reference.Add(v)
+		}
+
+		if i%1000 != 0 {
+			continue
+		}
+
+		// Assert
+		expect := reference.Values()
+		slices.Sort(expect)
+
+		require.Equal(t, expect, b.Values())
+		require.Equal(t, len(expect), b.Len())
+
+		probe := values[rand.Intn(len(values))] + rand.Intn(3) - 1
+		require.Equal(t, reference.Contains(probe), b.Contains(probe))
+
+		i, found := slices.BinarySearch(expect, probe)
+		require.Equal(t, optionalAt(expect, i), b.NextSet(probe), "NextSet(%d)", probe)
+
+		if !found {
+			i--
+		}
+
+		require.Equal(t, optionalAt(expect, i), b.PrevSet(probe), "PrevSet(%d)", probe)
+	}
+}
+
+func optionalAt(values []int, i int) optional.Optional[int] {
+	if i < 0 || i >= len(values) {
+		return optional.Empty[int]()
+	}
+
+	return optional.Of(values[i])
+}
+
+//nolint:funlen
+func TestBitSet_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(b *set.BitSet[int], others ...*set.BitSet[int])
+		reference func(s set.Set[int], others ...set.Set[int])
+	}
+
+	tests := []test{
+		{"union", (*set.BitSet[int]).Union, set.Set[int].Union},
+		{"intersection", (*set.BitSet[int]).Intersection, set.Set[int].Intersection},
+		{"difference", (*set.BitSet[int]).Difference, set.Set[int].Difference},
+		{"unique", (*set.BitSet[int]).Unique, set.Set[int].Unique},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			//nolint:gosec
+			rand := rand.New(rand.NewSource(11))
+
+			for numSets := 1; numSets <= 4; numSets++ {
+				// Arrange
+				bitSets := make([]*set.BitSet[int], numSets)
+				sets := make([]set.Set[int], numSets)
+
+				for i := range numSets {
+					values := randomBitSetValues(rand, rand.Intn(20_000))
+					bitSets[i] = set.BitSetOf(values...)
+					sets[i] = set.Of(values...)
+				}
+
+				others := slices.Clone(bitSets[1:])
+
+				// Act
+				tc.operation(bitSets[0], bitSets[1:]...)
+				tc.reference(sets[0], sets[1:]...)
+
+				// Assert
+				expect := sets[0].Values()
+				slices.Sort(expect)
+
+				require.Equal(t, expect, bitSets[0].Values())
+				require.Equal(t, len(expect), bitSets[0].Len())
+
+				for i, other := range others {
+					require.Equal(t, sets[i+1].Len(), other.Len(), "others must not be modified")
+				}
+			}
+		})
+	}
+}
+
+func TestBitSet_OperationsWithItself(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	values := randomBitSetValues(rand.New(rand.NewSource(13)), 10_000) //nolint:gosec
+	b := set.BitSetOf(values...)
+	expect := b.Values()
+
+	// Act & Assert
+	b.Union(b)
+	assert.Equal(t, expect, b.Values())
+
+	b.Intersection(b)
+	assert.Equal(t, expect, b.Values())
+
+	unique := b.Clone()
+	unique.Unique(unique)
+	assert.True(t, unique.IsEmpty())
+
+	b.Difference(b)
+	assert.True(t, b.IsEmpty())
+}
+
+func TestBitSet_Clone(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := set.BitSetOf[uint16]()
+	for i := range uint16(5000) {
+		b.Add(i * 3)
+	}
+
+	// Act
+	clone := b.Clone()
+	clone.Remove(0, 3)
+	clone.Add(1)
+
+	// Assert
+	assert.Equal(t, 5000, b.Len())
+	assert.True(t, b.Contains(0, 3))
+	assert.False(t, b.Contains(1))
+	assert.Equal(t, 4999, clone.Len())
+}
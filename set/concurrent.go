@@ -0,0 +1,378 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"hash/maphash"
+	"iter"
+	"math/bits"
+	"runtime"
+	"slices"
+	"sort"
+	"sync"
+	"sync/atomic"
+)
+
+//nolint:gochecknoglobals
+var concurrentIDs atomic.Uint64
+
+// Concurrent implements a thread-safe collection of unique, unordered values.
+//
+// The values are distributed over several shards, each guarded by its own
+// lock, so goroutines working on different values rarely block each other.
+//
+// Operations involving several sets (like [Concurrent.Union]) lock the shards
+// of all involved sets, always in the order the sets were created, so
+// concurrent operations like a.Union(b) and b.Union(a) cannot deadlock.
+//
+// A Concurrent must be created with [NewConcurrent] or
+// [NewConcurrentWithShards] and must not be copied after first use.
+type Concurrent[T comparable] struct {
+	id     uint64
+	seed   maphash.Seed
+	shards []shard[T]
+}
+
+type shard[T comparable] struct {
+	sync.RWMutex
+
+	values map[T]placeholderType
+}
+
+// NewConcurrent creates a new thread-safe set with the given values. The number
+// of shards is derived from GOMAXPROCS.
+func NewConcurrent[T comparable](values ...T) *Concurrent[T] {
+	return NewConcurrentWithShards(4*runtime.GOMAXPROCS(0), values...) //nolint:mnd
+}
+
+// NewConcurrentWithShards creates a new thread-safe set with the given values
+// and at least the given number of shards. More shards reduce contention, but
+// make operations on the whole set, like [Concurrent.Len], more expensive.
+//
+// Panics if shards is not positive.
+func NewConcurrentWithShards[T comparable](shards int, values ...T) *Concurrent[T] {
+	if shards <= 0 {
+		panic("number of shards must be positive")
+	}
+
+	// A power of two allows selecting the shard by masking the hash.
+	shards = 1 << bits.Len(uint(shards-1))
+
+	c := &Concurrent[T]{
+		id:     concurrentIDs.Add(1),
+		seed:   maphash.MakeSeed(),
+		shards: make([]shard[T], shards),
+	}
+
+	for i := range c.shards {
+		c.shards[i].values = make(map[T]placeholderType, len(values)/shards)
+	}
+
+	c.Add(values...)
+
+	return c
+}
+
+// Add adds the given values to the set if they are not already present.
+func (c *Concurrent[T]) Add(values ...T) {
+	for _, v := range values {
+		c.AddIfAbsent(v)
+	}
+}
+
+// AddIfAbsent adds the given value to the set and reports whether it was
+// absent before, so the set changed.
+func (c *Concurrent[T]) AddIfAbsent(value T) bool {
+	s := c.shardOf(value)
+
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.values[value]; ok {
+		return false
+	}
+
+	s.values[value] = placeholder
+
+	return true
+}
+
+// Remove removes the given values from the set.
+func (c *Concurrent[T]) Remove(values ...T) {
+	for _, v := range values {
+		c.RemoveIfPresent(v)
+	}
+}
+
+// RemoveIfPresent removes the given value from the set and reports whether it
+// was present before, so the set changed.
+func (c *Concurrent[T]) RemoveIfPresent(value T) bool {
+	s := c.shardOf(value)
+
+	s.Lock()
+	defer s.Unlock()
+
+	if _, ok := s.values[value]; !ok {
+		return false
+	}
+
+	delete(s.values, value)
+
+	return true
+}
+
+// Contains checks if the set contains all the given values.
+//
+// The check is atomic for all values, no value is added or removed in between.
+func (c *Concurrent[T]) Contains(values ...T) bool {
+	if len(values) == 1 {
+		s := c.shardOf(values[0])
+
+		s.RLock()
+		defer s.RUnlock()
+
+		_, ok := s.values[values[0]]
+
+		return ok
+	}
+
+	c.rLockAll()
+	defer c.rUnlockAll()
+
+	return c.containsLocked(values...)
+}
+
+// Clear removes all values from the set.
+func (c *Concurrent[T]) Clear() {
+	c.lockAll()
+	defer c.unlockAll()
+
+	for i := range c.shards {
+		clear(c.shards[i].values)
+	}
+}
+
+// Len returns the number of values in the set.
+func (c *Concurrent[T]) Len() int {
+	c.rLockAll()
+	defer c.rUnlockAll()
+
+	return c.lenLocked()
+}
+
+// IsEmpty returns true if the set is empty.
+func (c *Concurrent[T]) IsEmpty() bool {
+	return c.Len() == 0
+}
+
+// Values returns a snapshot of all values in the set without any particular
+// order.
+func (c *Concurrent[T]) Values() []T {
+	c.rLockAll()
+	defer c.rUnlockAll()
+
+	values := make([]T, 0, c.lenLocked())
+
+	for i := range c.shards {
+		for v := range c.shards[i].values {
+			values = append(values, v)
+		}
+	}
+
+	return values
+}
+
+// All creates an iterator over a snapshot of all values in the set without any
+// particular order.
+//
+// The snapshot is taken, when the iteration starts, so no lock is held while
+// ranging and the set may be modified in the loop body.
+func (c *Concurrent[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, v := range c.Values() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// Snapshot returns a non thread-safe [Set] with all values currently in the
+// set.
+func (c *Concurrent[T]) Snapshot() Set[T] {
+	return Of(c.Values()...)
+}
+
+// Clone creates a new thread-safe set with the same values and number of
+// shards.
+func (c *Concurrent[T]) Clone() *Concurrent[T] {
+	return NewConcurrentWithShards(len(c.shards), c.Values()...)
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(Concurrent[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(Concurrent[{{type}}]: <empty>)"
+//
+// The values are sorted by their string representation for easier overview,
+// the actual set is not sorted.
+func (c *Concurrent[T]) String() string {
+	values := c.Values()
+	if len(values) == 0 {
+		return fmt.Sprintf("(Concurrent[%T]: <empty>)", *new(T))
+	}
+
+	formatted := make([]string, 0, len(values))
+	for _, v := range values {
+		formatted = append(formatted, fmt.Sprintf("%v", v))
+	}
+
+	sort.Strings(formatted)
+
+	return fmt.Sprintf("(Concurrent[%T]: %s)", *new(T), formatted)
+}
+
+// Union adds all values from the given sets to the current set.
+func (c *Concurrent[T]) Union(others ...*Concurrent[T]) {
+	unlock := lockInOrder(c, others)
+	defer unlock()
+
+	for _, other := range others {
+		if other == c {
+			continue
+		}
+
+		for i := range other.shards {
+			for v := range other.shards[i].values {
+				c.shardOf(v).values[v] = placeholder
+			}
+		}
+	}
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets.
+func (c *Concurrent[T]) Intersection(others ...*Concurrent[T]) {
+	unlock := lockInOrder(c, others)
+	defer unlock()
+
+	for i := range c.shards {
+		for v := range c.shards[i].values {
+			if !allContainsLocked(others, v) {
+				delete(c.shards[i].values, v)
+			}
+		}
+	}
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets.
+func (c *Concurrent[T]) Difference(others ...*Concurrent[T]) {
+	unlock := lockInOrder(c, others)
+	defer unlock()
+
+	for _, other := range others {
+		if other == c {
+			for i := range c.shards {
+				clear(c.shards[i].values)
+			}
+
+			return
+		}
+
+		for i := range other.shards {
+			for v := range other.shards[i].values {
+				delete(c.shardOf(v).values, v)
+			}
+		}
+	}
+}
+
+func (c *Concurrent[T]) shardOf(value T) *shard[T] {
+	hash := maphash.Comparable(c.seed, value)
+
+	return &c.shards[hash&uint64(len(c.shards)-1)]
+}
+
+func (c *Concurrent[T]) containsLocked(values ...T) bool {
+	for _, v := range values {
+		if _, ok := c.shardOf(v).values[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (c *Concurrent[T]) lenLocked() int {
+	length := 0
+	for i := range c.shards {
+		length += len(c.shards[i].values)
+	}
+
+	return length
+}
+
+func (c *Concurrent[T]) lockAll() {
+	for i := range c.shards {
+		c.shards[i].Lock()
+	}
+}
+
+func (c *Concurrent[T]) unlockAll() {
+	for i := range c.shards {
+		c.shards[i].Unlock()
+	}
+}
+
+func (c *Concurrent[T]) rLockAll() {
+	for i := range c.shards {
+		c.shards[i].RLock()
+	}
+}
+
+func (c *Concurrent[T]) rUnlockAll() {
+	for i := range c.shards {
+		c.shards[i].RUnlock()
+	}
+}
+
+// lockInOrder write locks all shards of target and read locks all shards of
+// others, ordered by the creation of the sets to prevent deadlocks. It returns
+// a function to release all locks again.
+func lockInOrder[T comparable](target *Concurrent[T], others []*Concurrent[T]) (unlock func()) {
+	sets := append([]*Concurrent[T]{target}, others...)
+
+	slices.SortFunc(sets, func(a, b *Concurrent[T]) int {
+		return cmp.Compare(a.id, b.id)
+	})
+
+	sets = slices.Compact(sets)
+
+	for _, s := range sets {
+		if s == target {
+			s.lockAll()
+		} else {
+			s.rLockAll()
+		}
+	}
+
+	return func() {
+		for _, s := range slices.Backward(sets) {
+			if s == target {
+				s.unlockAll()
+			} else {
+				s.rUnlockAll()
+			}
+		}
+	}
+}
+
+func allContainsLocked[T comparable](others []*Concurrent[T], v T) bool {
+	for _, other := range others {
+		if !other.containsLocked(v) {
+			return false
+		}
+	}
+
+	return true
+}
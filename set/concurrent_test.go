@@ -0,0 +1,300 @@
+package set_test
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleConcurrent_AddIfAbsent() {
+	seen := set.NewConcurrent[string]()
+
+	var wg sync.WaitGroup
+
+	var firstVisits atomic.Int32
+
+	for range 8 {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			if seen.AddIfAbsent("https://example.com") {
+				firstVisits.Add(1)
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	fmt.Println(firstVisits.Load(), seen)
+
+	// Output: 1 (Concurrent[string]: [https://example.com])
+}
+
+func TestNewConcurrent(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	empty := set.NewConcurrent[int]()
+	filled := set.NewConcurrentWithShards(3, 1, 2, 2, 3)
+
+	// Assert
+	assert.True(t, empty.IsEmpty())
+	assert.Equal(t, "(Concurrent[int]: <empty>)", empty.String())
+	assert.Equal(t, 3, filled.Len())
+	assert.True(t, filled.Contains(1, 2, 3))
+	assert.False(t, filled.Contains(1, 4))
+	assert.ElementsMatch(t, []int{1, 2, 3}, filled.Values())
+	assert.True(t, filled.Snapshot().ContainsExactly(1, 2, 3))
+	assert.Panics(t, func() { set.NewConcurrentWithShards[int](0) })
+}
+
+func TestConcurrent_AddRemove(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.NewConcurrent[string]()
+
+	// Act & Assert
+	assert.True(t, s.AddIfAbsent("a"))
+	assert.False(t, s.AddIfAbsent("a"))
+	assert.True(t, s.Contains("a"))
+
+	s.Add("b", "c", "b")
+	assert.Equal(t, 3, s.Len())
+
+	assert.True(t, s.RemoveIfPresent("a"))
+	assert.False(t, s.RemoveIfPresent("a"))
+	assert.False(t, s.Contains("a"))
+
+	s.Remove("b", "x")
+	assert.Equal(t, "(Concurrent[string]: [c])", s.String())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+}
+
+func TestConcurrent_All(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.NewConcurrent(1, 2, 3, 4, 5)
+
+	// Act
+	var values []int
+
+	for v := range s.All() {
+		// The iteration works on a snapshot, so modifying is allowed.
+		s.Remove(v)
+		s.Add(v + 100)
+
+		values = append(values, v)
+	}
+
+	// Assert
+	assert.ElementsMatch(t, []int{1, 2, 3, 4, 5}, values)
+	assert.ElementsMatch(t, []int{101, 102, 103, 104, 105}, s.Values())
+}
+
+func TestConcurrent_Clone(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.NewConcurrent(1, 2, 3)
+
+	// Act
+	clone := s.Clone()
+	clone.Add(4)
+
+	// Assert
+	assert.ElementsMatch(t, []int{1, 2, 3}, s.Values())
+	assert.ElementsMatch(t, []int{1, 2, 3, 4}, clone.Values())
+}
+
+func TestConcurrent_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(c *set.Concurrent[int], others ...*set.Concurrent[int])
+		reference func(s set.Set[int], others ...set.Set[int])
+	}
+
+	tests := []test{
+		{"union", (*set.Concurrent[int]).Union, set.Set[int].Union},
+		{"intersection", (*set.Concurrent[int]).Intersection, set.Set[int].Intersection},
+		{"difference", (*set.Concurrent[int]).Difference, set.Set[int].Difference},
+	}
+
+	values := [][]int{{1, 2, 3, 4, 5, 6}, {2, 4, 6, 8}, {4, 5, 6, 7}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			concurrent := make([]*set.Concurrent[int], len(values))
+			reference := make([]set.Set[int], len(values))
+
+			for i, v := range values {
+				concurrent[i] = set.NewConcurrentWithShards(i+1, v...)
+				reference[i] = set.Of(v...)
+			}
+
+			// Act
+			tc.operation(concurrent[0], concurrent[1:]...)
+			tc.reference(reference[0], reference[1:]...)
+
+			// Assert
+			assert.ElementsMatch(t, reference[0].Values(), concurrent[0].Values())
+		})
+	}
+}
+
+func TestConcurrent_Operations_withItself(t *testing.T) {
+	t.Parallel()
+
+	union := set.NewConcurrent(1, 2)
+	union.Union(union)
+	assert.ElementsMatch(t, []int{1, 2}, union.Values())
+
+	intersection := set.NewConcurrent(1, 2)
+	intersection.Intersection(intersection, intersection)
+	assert.ElementsMatch(t, []int{1, 2}, intersection.Values())
+
+	difference := set.NewConcurrent(1, 2)
+	difference.Difference(difference)
+	assert.True(t, difference.IsEmpty())
+}
+
+func TestConcurrent_race_AddIfAbsent(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const (
+		goroutines = 16
+		values     = 1000
+	)
+
+	s := set.NewConcurrent[int]()
+
+	var (
+		wg    sync.WaitGroup
+		added atomic.Int64
+	)
+
+	// Act
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for v := range values {
+				if s.AddIfAbsent(v) {
+					added.Add(1)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int64(values), added.Load())
+	assert.Equal(t, values, s.Len())
+}
+
+func TestConcurrent_race_RemoveIfPresent(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const (
+		goroutines = 16
+		values     = 1000
+	)
+
+	s := set.NewConcurrent[int]()
+	for v := range values {
+		s.Add(v)
+	}
+
+	var (
+		wg      sync.WaitGroup
+		removed atomic.Int64
+	)
+
+	// Act
+	for range goroutines {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for v := range values {
+				if s.RemoveIfPresent(v) {
+					removed.Add(1)
+				}
+			}
+		}()
+	}
+
+	wg.Wait()
+
+	// Assert
+	assert.Equal(t, int64(values), removed.Load())
+	assert.True(t, s.IsEmpty())
+}
+
+//nolint:funlen
+func TestConcurrent_race_mixedOperations(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := set.NewConcurrentWithShards(4, 1, 2, 3)
+	b := set.NewConcurrentWithShards(8, 3, 4, 5)
+
+	var wg sync.WaitGroup
+
+	run := func(fn func(i int)) {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			for i := range 200 {
+				fn(i)
+			}
+		}()
+	}
+
+	// Act
+	// Operations in both directions would deadlock without consistent lock
+	// order.
+	run(func(int) { a.Union(b) })
+	run(func(int) { b.Union(a) })
+	run(func(int) { a.Intersection(b, a) })
+	run(func(int) { b.Difference(a) })
+	run(func(i int) { a.Add(i) })
+	run(func(i int) { b.Remove(i) })
+	run(func(int) {
+		for v := range a.All() {
+			_ = b.Contains(v, v+1)
+		}
+	})
+	run(func(int) { _ = a.Len() + b.Len() })
+	run(func(int) { _ = a.String() })
+
+	wg.Wait()
+
+	// Assert
+	assert.Len(t, a.Values(), a.Len())
+	assert.True(t, a.Contains(a.Values()...))
+	assert.True(t, b.Contains(b.Values()...))
+}
@@ -0,0 +1,190 @@
+package set
+
+import (
+	"iter"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// DisjointSet partitions values into disjoint components, e.g. clusters of
+// duplicates or connected components of a graph. It is also known as
+// union-find.
+//
+// Each value starts in its own component, [DisjointSet.Union] merges the
+// components of two values. Each component is identified by a representative
+// value returned by [DisjointSet.Find]. By union by rank and path compression,
+// all operations take nearly constant amortized time.
+//
+// The zero value is an empty disjoint set. It is not thread-safe.
+type DisjointSet[T comparable] struct {
+	// index maps the values to their position in the other slices.
+	index  map[T]int
+	values []T
+	// parent is the index of the parent of each value, roots are their own
+	// parent.
+	parent []int
+	// rank is an upper bound of the height of the tree of each root.
+	rank []uint8
+	// size is the number of values in the component of each root.
+	size       []int
+	components int
+}
+
+// NewDisjointSet creates a new disjoint set, with each given value in its own
+// component.
+func NewDisjointSet[T comparable](values ...T) *DisjointSet[T] {
+	d := &DisjointSet[T]{index: make(map[T]int, len(values))}
+
+	d.Add(values...)
+
+	return d
+}
+
+// Add adds each given value in its own component, if it is not already
+// present.
+func (d *DisjointSet[T]) Add(values ...T) {
+	for _, v := range values {
+		d.indexOf(v)
+	}
+}
+
+// Union merges the components of a and b, adding them first if they are not
+// present. Returns true if they were in different components before.
+func (d *DisjointSet[T]) Union(a, b T) bool {
+	rootA, rootB := d.root(d.indexOf(a)), d.root(d.indexOf(b))
+	if rootA == rootB {
+		return false
+	}
+
+	// Attaching the lower tree to the higher one keeps the trees flat.
+	if d.rank[rootA] < d.rank[rootB] {
+		rootA, rootB = rootB, rootA
+	}
+
+	d.parent[rootB] = rootA
+	d.size[rootA] += d.size[rootB]
+
+	if d.rank[rootA] == d.rank[rootB] {
+		d.rank[rootA]++
+	}
+
+	d.components--
+
+	return true
+}
+
+// Find returns the representative value of the component containing v, or an
+// empty optional if v is not present.
+//
+// The representative is the same for all values of a component, until it is
+// merged with another component.
+func (d *DisjointSet[T]) Find(v T) optional.Optional[T] {
+	i, ok := d.index[v]
+	if !ok {
+		return optional.Empty[T]()
+	}
+
+	return optional.Of(d.values[d.root(i)])
+}
+
+// Connected checks if a and b are present and in the same component.
+func (d *DisjointSet[T]) Connected(a, b T) bool {
+	i, ok := d.index[a]
+	if !ok {
+		return false
+	}
+
+	j, ok := d.index[b]
+	if !ok {
+		return false
+	}
+
+	return d.root(i) == d.root(j)
+}
+
+// Size returns the number of values in the component containing v, or 0 if v
+// is not present.
+func (d *DisjointSet[T]) Size(v T) int {
+	i, ok := d.index[v]
+	if !ok {
+		return 0
+	}
+
+	return d.size[d.root(i)]
+}
+
+// Len returns the number of values.
+func (d *DisjointSet[T]) Len() int {
+	return len(d.values)
+}
+
+// ComponentCount returns the number of components.
+func (d *DisjointSet[T]) ComponentCount() int {
+	return d.components
+}
+
+// Components creates an iterator over all components, each as a [Set] of its
+// values, without any particular order.
+//
+// The components are collected when the iteration starts, so modifying the
+// disjoint set during the iteration does not affect it.
+func (d *DisjointSet[T]) Components() iter.Seq[Set[T]] {
+	return func(yield func(Set[T]) bool) {
+		components := make(map[int]Set[T], d.components)
+
+		for i, v := range d.values {
+			root := d.root(i)
+
+			component, ok := components[root]
+			if !ok {
+				component = WithCapacity[T](d.size[root])
+				components[root] = component
+			}
+
+			component.Add(v)
+		}
+
+		for _, component := range components {
+			if !yield(component) {
+				return
+			}
+		}
+	}
+}
+
+// indexOf returns the index of v, adding it first if it is not present.
+func (d *DisjointSet[T]) indexOf(v T) int {
+	if i, ok := d.index[v]; ok {
+		return i
+	}
+
+	if d.index == nil {
+		d.index = make(map[T]int)
+	}
+
+	i := len(d.values)
+
+	d.index[v] = i
+	d.values = append(d.values, v)
+	d.parent = append(d.parent, i)
+	d.rank = append(d.rank, 0)
+	d.size = append(d.size, 1)
+	d.components++
+
+	return i
+}
+
+// root returns the index of the root of i, pointing all values on the way
+// directly to the root.
+func (d *DisjointSet[T]) root(i int) int {
+	root := i
+	for d.parent[root] != root {
+		root = d.parent[root]
+	}
+
+	for d.parent[i] != root {
+		d.parent[i], i = root, d.parent[i]
+	}
+
+	return root
+}
@@ -0,0 +1,109 @@
+package set_test
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleDisjointSet() {
+	duplicates := set.NewDisjointSet[string]()
+
+	duplicates.Union("alice@example.com", "a.smith@example.com")
+	duplicates.Union("bob@example.com", "bobby@example.com")
+	duplicates.Union("a.smith@example.com", "alice.smith@example.com")
+	duplicates.Add("carol@example.com")
+
+	fmt.Println(duplicates.Connected("alice@example.com", "alice.smith@example.com"))
+	fmt.Println(duplicates.Size("bob@example.com"), duplicates.ComponentCount())
+
+	var clusters []string
+	for component := range duplicates.Components() {
+		clusters = append(clusters, component.String())
+	}
+
+	slices.Sort(clusters)
+	fmt.Println(strings.Join(clusters, "\n"))
+
+	// Output:
+	// true
+	// 2 3
+	// (Set[string]: [a.smith@example.com alice.smith@example.com alice@example.com])
+	// (Set[string]: [bob@example.com bobby@example.com])
+	// (Set[string]: [carol@example.com])
+}
+
+func TestDisjointSet_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var d set.DisjointSet[int]
+
+	// Assert
+	assert.Equal(t, 0, d.Len())
+	assert.Equal(t, 0, d.ComponentCount())
+	assert.Equal(t, optional.Empty[int](), d.Find(1))
+	assert.Equal(t, 0, d.Size(1))
+	assert.False(t, d.Connected(1, 1))
+	assert.Empty(t, slices.Collect(d.Components()))
+
+	// Act
+	merged := d.Union(1, 2)
+
+	// Assert
+	assert.True(t, merged)
+	assert.True(t, d.Connected(2, 1))
+	assert.Equal(t, 2, d.Len())
+}
+
+func TestDisjointSet_Union(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	d := set.NewDisjointSet(1, 2, 3, 4, 5)
+
+	// Act & Assert
+	assert.True(t, d.Union(1, 2))
+	assert.True(t, d.Union(3, 4))
+	assert.False(t, d.Union(2, 1))
+	assert.True(t, d.Union(2, 4))
+	assert.False(t, d.Union(1, 3))
+	assert.False(t, d.Union(5, 5))
+
+	assert.Equal(t, 2, d.ComponentCount())
+	assert.Equal(t, 4, d.Size(3))
+	assert.Equal(t, 1, d.Size(5))
+	assert.True(t, d.Connected(1, 4))
+	assert.False(t, d.Connected(1, 5))
+	assert.False(t, d.Connected(1, 6))
+	assert.Equal(t, d.Find(1), d.Find(4))
+	assert.Equal(t, optional.Of(5), d.Find(5))
+
+	d.Add(5, 6)
+	assert.Equal(t, 6, d.Len())
+	assert.Equal(t, 3, d.ComponentCount())
+}
+
+func TestDisjointSet_Components_break(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	d := set.NewDisjointSet(1, 2, 3)
+
+	// Act
+	count := 0
+	for range d.Components() {
+		count++
+
+		break
+	}
+
+	// Assert
+	assert.Equal(t, 1, count)
+}
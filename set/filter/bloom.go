@@ -0,0 +1,221 @@
+package filter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/bits"
+)
+
+// bloomMagic identifies marshalled Bloom filters, its last byte is the version
+// of the format.
+const bloomMagic = "BLM\x01"
+
+// maxBloomHashes limits the number of bits set per value. It is the optimum
+// for a false positive rate of 2⁻⁶⁴, lower rates can't be distinguished anyway.
+const maxBloomHashes = 64
+
+// Bloom is a Bloom filter, which tells if a value is possibly contained in it
+// or definitely not.
+//
+// Each value sets k bits of a bitmap, so the probability of false positives
+// grows with the number of values and can be estimated by
+// [Bloom.EstimatedFalsePositiveRate]. Values can't be removed.
+//
+// A Bloom must be created with [NewBloom] or unmarshalled. It is not
+// thread-safe.
+type Bloom[T Key] struct {
+	words []uint64
+	// bits is the number of bits used, the last word may be used partially.
+	bits uint64
+	// hashes is the number of bits set per value.
+	hashes uint64
+}
+
+// NewBloom creates a Bloom filter, which has the given false positive rate when
+// containing the expected number of values. Rates below 2⁻⁶⁴ are not reached,
+// as at most 64 bits are set per value.
+//
+// Panics if expected is not positive or falsePositiveRate is not within (0, 1).
+func NewBloom[T Key](expected int, falsePositiveRate float64) *Bloom[T] {
+	if expected <= 0 {
+		panic(fmt.Sprintf("expected number of values must be positive, but was %d", expected))
+	}
+
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic(fmt.Sprintf("false positive rate must be within (0, 1), but was %v", falsePositiveRate))
+	}
+
+	// Optimal number of bits m = -n*ln(p)/ln(2)^2 and hashes k = m/n*ln(2).
+	n := float64(expected)
+	m := math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2))
+	k := min(max(1, math.Round(m/n*math.Ln2)), maxBloomHashes)
+
+	return newBloom[T](uint64(m), uint64(k))
+}
+
+func newBloom[T Key](numBits, hashes uint64) *Bloom[T] {
+	return &Bloom[T]{
+		words:  make([]uint64, (numBits+63)/64),
+		bits:   numBits,
+		hashes: hashes,
+	}
+}
+
+// Add adds the given values to the filter.
+func (b *Bloom[T]) Add(values ...T) {
+	for _, v := range values {
+		h1, h2 := b.hashPair(v)
+
+		for i := range b.hashes {
+			bit := (h1 + i*h2) % b.bits
+			b.words[bit/64] |= 1 << (bit % 64)
+		}
+	}
+}
+
+// Contains checks if the filter possibly contains all the given values. If it
+// returns false, at least one value was definitely never added.
+func (b *Bloom[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		h1, h2 := b.hashPair(v)
+
+		for i := range b.hashes {
+			bit := (h1 + i*h2) % b.bits
+			if b.words[bit/64]&(1<<(bit%64)) == 0 {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// Clear removes all values from the filter.
+func (b *Bloom[T]) Clear() {
+	clear(b.words)
+}
+
+// IsEmpty returns true if no value was added to the filter.
+func (b *Bloom[T]) IsEmpty() bool {
+	for _, word := range b.words {
+		if word != 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// FillRate returns the fraction of bits set, between 0 and 1.
+func (b *Bloom[T]) FillRate() float64 {
+	set := 0
+	for _, word := range b.words {
+		set += bits.OnesCount64(word)
+	}
+
+	return float64(set) / float64(b.bits)
+}
+
+// EstimatedLen estimates the number of distinct values added from the
+// [Bloom.FillRate].
+func (b *Bloom[T]) EstimatedLen() int {
+	// n ≈ -m/k * ln(1 - X/m), with X set bits.
+	estimate := -float64(b.bits) / float64(b.hashes) * math.Log1p(-b.FillRate())
+	if math.IsInf(estimate, 1) {
+		return math.MaxInt
+	}
+
+	return int(math.Round(estimate))
+}
+
+// EstimatedFalsePositiveRate estimates the probability, that
+// [Bloom.Contains] returns true for a value never added, from the
+// [Bloom.FillRate].
+func (b *Bloom[T]) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(b.FillRate(), float64(b.hashes))
+}
+
+// Union adds all values of the other filters to the filter, as if they were
+// added directly.
+//
+// Returns [ErrIncompatible] and does not modify the filter, if any other
+// filter was created with different parameters.
+func (b *Bloom[T]) Union(others ...*Bloom[T]) error {
+	for _, other := range others {
+		if other.bits != b.bits || other.hashes != b.hashes {
+			return fmt.Errorf("%w: %d bits and %d hashes can't be merged with %d bits and %d hashes",
+				ErrIncompatible, other.bits, other.hashes, b.bits, b.hashes)
+		}
+	}
+
+	for _, other := range others {
+		for i, word := range other.words {
+			b.words[i] |= word
+		}
+	}
+
+	return nil
+}
+
+// Clone creates a copy of the filter.
+func (b *Bloom[T]) Clone() *Bloom[T] {
+	clone := newBloom[T](b.bits, b.hashes)
+	copy(clone.words, b.words)
+
+	return clone
+}
+
+// MarshalBinary encodes the filter into a platform independent binary format.
+func (b *Bloom[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(bloomMagic)+16+8*len(b.words))
+
+	data = append(data, bloomMagic...)
+	data = binary.BigEndian.AppendUint64(data, b.bits)
+	data = binary.BigEndian.AppendUint64(data, b.hashes)
+
+	for _, word := range b.words {
+		data = binary.BigEndian.AppendUint64(data, word)
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes data created by [Bloom.MarshalBinary] into the
+// filter, replacing its previous parameters and values.
+func (b *Bloom[T]) UnmarshalBinary(data []byte) error {
+	const headerLen = len(bloomMagic) + 16
+
+	if len(data) < headerLen || string(data[:len(bloomMagic)]) != bloomMagic {
+		return fmt.Errorf("%w: missing Bloom filter header", ErrInvalidData)
+	}
+
+	numBits := binary.BigEndian.Uint64(data[len(bloomMagic):])
+	hashes := binary.BigEndian.Uint64(data[len(bloomMagic)+8:])
+	words := data[headerLen:]
+	numWords := uint64(len(words) / 8)
+
+	if numBits == 0 || hashes == 0 || hashes > maxBloomHashes || len(words)%8 != 0 || (numBits-1)/64+1 != numWords {
+		return fmt.Errorf("%w: %d bytes don't match %d bits and %d hashes",
+			ErrInvalidData, len(words), numBits, hashes)
+	}
+
+	*b = *newBloom[T](numBits, hashes)
+
+	for i := range b.words {
+		b.words[i] = binary.BigEndian.Uint64(words[8*i:])
+	}
+
+	return nil
+}
+
+// hashPair derives the two hashes for double hashing as described by Kirsch
+// and Mitzenmacher. The second one is odd, so it is never zero and the probes
+// of a value don't all hit the same bit. As the number of bits is no power of
+// two, the probed bits may still coincide partially, which increases the false
+// positive rate only negligibly.
+func (b *Bloom[T]) hashPair(v T) (uint64, uint64) {
+	h := hashKey(v)
+
+	return h, mix(h) | 1
+}
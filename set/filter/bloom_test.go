@@ -0,0 +1,215 @@
+package filter_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set/filter"
+)
+
+func ExampleBloom() {
+	seen := filter.NewBloom[string](1000, 0.01)
+
+	seen.Add("alice", "bob")
+
+	fmt.Println(seen.Contains("alice"))
+	fmt.Println(seen.Contains("mallory"))
+
+	// Output:
+	// true
+	// false
+}
+
+// ids creates n distinct ids starting from the given offset.
+func ids(offset, n int) []string {
+	values := make([]string, n)
+	for i := range values {
+		values[i] = "id-" + strconv.Itoa(offset+i)
+	}
+
+	return values
+}
+
+// falsePositiveRate measures the fraction of the given values never added,
+// which are reported as possibly contained.
+func falsePositiveRate(contains func(values ...string) bool, values []string) float64 {
+	falsePositives := 0
+
+	for _, v := range values {
+		if contains(v) {
+			falsePositives++
+		}
+	}
+
+	return float64(falsePositives) / float64(len(values))
+}
+
+func TestBloom_falsePositiveRate(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name              string
+		expected          int
+		falsePositiveRate float64
+	}
+
+	tests := []test{
+		{"10%", 1_000, 0.1},
+		{"1%", 10_000, 0.01},
+		{"0.1%", 10_000, 0.001},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			b := filter.NewBloom[string](tc.expected, tc.falsePositiveRate)
+			added := ids(0, tc.expected)
+
+			// Act
+			b.Add(added...)
+
+			// Assert
+			require.True(t, b.Contains(added...), "no false negatives")
+
+			rate := falsePositiveRate(b.Contains, ids(tc.expected, 100_000))
+			assert.InDelta(t, tc.falsePositiveRate, rate, tc.falsePositiveRate*0.3)
+			assert.InDelta(t, tc.falsePositiveRate, b.EstimatedFalsePositiveRate(), tc.falsePositiveRate*0.3)
+			assert.InDelta(t, 0.5, b.FillRate(), 0.05, "optimal parameters set half of the bits")
+			assert.InDelta(t, tc.expected, b.EstimatedLen(), float64(tc.expected)*0.05)
+		})
+	}
+}
+
+func TestBloom_empty(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := filter.NewBloom[[]byte](100, 0.01)
+
+	// Assert
+	assert.True(t, b.IsEmpty())
+	assert.False(t, b.Contains([]byte("a")))
+	assert.Zero(t, b.FillRate())
+	assert.Zero(t, b.EstimatedLen())
+
+	// Act
+	b.Add([]byte("a"))
+	b.Clear()
+
+	// Assert
+	assert.True(t, b.IsEmpty())
+	assert.Panics(t, func() { filter.NewBloom[string](0, 0.01) })
+	assert.Panics(t, func() { filter.NewBloom[string](10, 1) })
+	assert.Panics(t, func() { filter.NewBloom[string](10, 0) })
+}
+
+func TestBloom_Union(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := filter.NewBloom[string](1000, 0.01)
+	b := filter.NewBloom[string](1000, 0.01)
+	incompatible := filter.NewBloom[string](1000, 0.02)
+
+	a.Add(ids(0, 500)...)
+	b.Add(ids(500, 500)...)
+
+	direct := a.Clone()
+	direct.Add(ids(500, 500)...)
+
+	// Act
+	err := a.Union(b)
+	errIncompatible := a.Union(b, incompatible)
+
+	// Assert
+	require.NoError(t, err)
+	require.ErrorIs(t, errIncompatible, filter.ErrIncompatible)
+	assert.True(t, a.Contains(ids(0, 1000)...))
+	assert.Equal(t, direct, a)
+}
+
+func TestBloom_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := filter.NewBloom[string](1000, 0.01)
+	b.Add(ids(0, 800)...)
+
+	// Act
+	data, err := b.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded filter.Bloom[string]
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, b, &decoded)
+	assert.True(t, decoded.Contains(ids(0, 800)...))
+
+	require.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-1]), filter.ErrInvalidData)
+	require.ErrorIs(t, decoded.UnmarshalBinary([]byte("CKO\x01")), filter.ErrInvalidData)
+	require.ErrorIs(t, decoded.UnmarshalBinary(nil), filter.ErrInvalidData)
+}
+
+func TestBloom_UnmarshalBinary_overflowingHeader(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	data := []byte("BLM\x01")
+	data = binary.BigEndian.AppendUint64(data, math.MaxUint64)
+	data = binary.BigEndian.AppendUint64(data, 3)
+
+	var decoded filter.Bloom[string]
+
+	// Act
+	err := decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.ErrorIs(t, err, filter.ErrInvalidData)
+}
+
+func TestBloom_UnmarshalBinary_tooManyHashes(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	data := []byte("BLM\x01")
+	data = binary.BigEndian.AppendUint64(data, 64)
+	data = binary.BigEndian.AppendUint64(data, math.MaxUint64)
+	data = binary.BigEndian.AppendUint64(data, 0)
+
+	var decoded filter.Bloom[string]
+
+	// Act
+	err := decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.ErrorIs(t, err, filter.ErrInvalidData)
+}
+
+func TestNewBloom_limitsHashes(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	b := filter.NewBloom[string](10, 1e-30)
+	b.Add("a")
+
+	// Act
+	data, err := b.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded filter.Bloom[string]
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, decoded.Contains("a"))
+}
@@ -0,0 +1,373 @@
+package filter
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+	"math/rand/v2"
+)
+
+const (
+	// cuckooMagic identifies marshalled Cuckoo filters, its last byte is the
+	// version of the format.
+	cuckooMagic = "CKO\x01"
+	// bucketSize is the number of fingerprints per bucket.
+	bucketSize = 4
+	// maxKicks limits the number of fingerprints relocated when adding a value.
+	maxKicks = 500
+	// maxLoadFactor is the load factor, up to which adding values usually
+	// succeeds with buckets of 4 fingerprints.
+	maxLoadFactor = 0.95
+)
+
+// bucket holds the fingerprints of a Cuckoo filter, 0 marks empty slots.
+type bucket [bucketSize]uint16
+
+// Cuckoo is a Cuckoo filter as described by Fan, Andersen, Kaminsky and
+// Mitzenmacher, which tells if a value is possibly contained in it or
+// definitely not, and supports removing values.
+//
+// It stores a 16 bit fingerprint of each value in one of two possible buckets,
+// so the false positive rate is below 0.02 % and does not grow with the number
+// of values. Adding fails with [ErrFull], when the filter reaches its capacity.
+//
+// A Cuckoo must be created with [NewCuckoo] or unmarshalled. It is not
+// thread-safe.
+type Cuckoo[T Key] struct {
+	buckets []bucket
+	count   int
+	// victim holds a fingerprint, which couldn't be relocated after adding a
+	// value failed, so the filter has no false negatives.
+	victim      uint16
+	victimIndex uint64
+}
+
+// NewCuckoo creates a Cuckoo filter with space for at least capacity values.
+//
+// Panics if capacity is not positive.
+func NewCuckoo[T Key](capacity int) *Cuckoo[T] {
+	if capacity <= 0 {
+		panic(fmt.Sprintf("capacity must be positive, but was %d", capacity))
+	}
+
+	numBuckets := uint64(float64(capacity)/bucketSize/maxLoadFactor) + 1
+
+	// A power of two allows finding the alternative bucket by xor.
+	return &Cuckoo[T]{buckets: make([]bucket, 1<<bits.Len64(numBuckets-1))}
+}
+
+// Add adds the given values to the filter. Values added several times must be
+// removed as many times.
+//
+// Returns [ErrFull] if a value can't be added, the following values are not
+// added then.
+func (c *Cuckoo[T]) Add(values ...T) error {
+	for _, v := range values {
+		fingerprint, index := c.locate(v)
+
+		err := c.insert(fingerprint, index)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Remove removes the given values from the filter.
+//
+// Only values which were added must be removed, otherwise the fingerprint of
+// another value may be removed, causing a false negative.
+func (c *Cuckoo[T]) Remove(values ...T) {
+	for _, v := range values {
+		fingerprint, index := c.locate(v)
+
+		switch {
+		case c.buckets[index].remove(fingerprint):
+		case c.buckets[c.alternative(index, fingerprint)].remove(fingerprint):
+		case c.victim == fingerprint && (c.victimIndex == index || c.victimIndex == c.alternative(index, fingerprint)):
+			c.victim = 0
+		default:
+			continue
+		}
+
+		c.count--
+
+		c.reinsertVictim()
+	}
+}
+
+// Contains checks if the filter possibly contains all the given values. If it
+// returns false, at least one value is definitely not contained.
+func (c *Cuckoo[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		fingerprint, index := c.locate(v)
+		alternative := c.alternative(index, fingerprint)
+
+		if !c.buckets[index].contains(fingerprint) &&
+			!c.buckets[alternative].contains(fingerprint) &&
+			(c.victim != fingerprint || c.victimIndex != index && c.victimIndex != alternative) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values in the filter.
+func (c *Cuckoo[T]) Len() int {
+	return c.count
+}
+
+// IsEmpty returns true if the filter contains no values.
+func (c *Cuckoo[T]) IsEmpty() bool {
+	return c.count == 0
+}
+
+// Clear removes all values from the filter.
+func (c *Cuckoo[T]) Clear() {
+	clear(c.buckets)
+	c.count = 0
+	c.victim = 0
+}
+
+// FillRate returns the fraction of used slots, between 0 and 1. Adding values
+// may fail from a fill rate of about 0.95 on.
+func (c *Cuckoo[T]) FillRate() float64 {
+	return float64(c.count) / float64(len(c.buckets)*bucketSize)
+}
+
+// Merge adds all values of the other filters to the filter.
+//
+// Returns [ErrIncompatible] and does not modify the filter, if any other
+// filter has a different number of buckets. Returns [ErrFull], if not all
+// values fit into the filter, the filter contains all values merged until
+// then.
+func (c *Cuckoo[T]) Merge(others ...*Cuckoo[T]) error {
+	for _, other := range others {
+		if len(other.buckets) != len(c.buckets) {
+			return fmt.Errorf("%w: %d buckets can't be merged with %d buckets",
+				ErrIncompatible, len(other.buckets), len(c.buckets))
+		}
+	}
+
+	for _, other := range others {
+		if other == c {
+			other = other.Clone()
+		}
+
+		for index, b := range other.buckets {
+			for _, fingerprint := range b {
+				if fingerprint == 0 {
+					continue
+				}
+
+				err := c.insert(fingerprint, uint64(index))
+				if err != nil {
+					return err
+				}
+			}
+		}
+
+		if other.victim != 0 {
+			err := c.insert(other.victim, other.victimIndex)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Clone creates a copy of the filter.
+func (c *Cuckoo[T]) Clone() *Cuckoo[T] {
+	clone := *c
+	clone.buckets = make([]bucket, len(c.buckets))
+	copy(clone.buckets, c.buckets)
+
+	return &clone
+}
+
+// MarshalBinary encodes the filter into a platform independent binary format.
+func (c *Cuckoo[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, 0, len(cuckooMagic)+26+2*bucketSize*len(c.buckets))
+
+	data = append(data, cuckooMagic...)
+	data = binary.BigEndian.AppendUint64(data, uint64(len(c.buckets)))
+	data = binary.BigEndian.AppendUint64(data, uint64(c.count)) //nolint:gosec // count is not negative
+	data = binary.BigEndian.AppendUint16(data, c.victim)
+	data = binary.BigEndian.AppendUint64(data, c.victimIndex)
+
+	for _, b := range c.buckets {
+		for _, fingerprint := range b {
+			data = binary.BigEndian.AppendUint16(data, fingerprint)
+		}
+	}
+
+	return data, nil
+}
+
+// UnmarshalBinary decodes data created by [Cuckoo.MarshalBinary] into the
+// filter, replacing its previous capacity and values.
+func (c *Cuckoo[T]) UnmarshalBinary(data []byte) error {
+	const headerLen = len(cuckooMagic) + 26
+
+	if len(data) < headerLen || string(data[:len(cuckooMagic)]) != cuckooMagic {
+		return fmt.Errorf("%w: missing Cuckoo filter header", ErrInvalidData)
+	}
+
+	header := data[len(cuckooMagic):]
+	numBuckets := binary.BigEndian.Uint64(header)
+	count := binary.BigEndian.Uint64(header[8:])
+	victim := binary.BigEndian.Uint16(header[16:])
+	victimIndex := binary.BigEndian.Uint64(header[18:])
+	fingerprints := data[headerLen:]
+
+	if numBuckets == 0 || numBuckets&(numBuckets-1) != 0 ||
+		len(fingerprints)%(2*bucketSize) != 0 || uint64(len(fingerprints)/(2*bucketSize)) != numBuckets ||
+		count > numBuckets*bucketSize+1 || victimIndex >= numBuckets {
+		return fmt.Errorf("%w: inconsistent Cuckoo filter header", ErrInvalidData)
+	}
+
+	decoded := Cuckoo[T]{
+		buckets:     make([]bucket, numBuckets),
+		count:       int(count), //nolint:gosec // checked above
+		victim:      victim,
+		victimIndex: victimIndex,
+	}
+
+	occupied := uint64(0)
+	if victim != 0 {
+		occupied++
+	}
+
+	for i := range decoded.buckets {
+		for j := range bucketSize {
+			decoded.buckets[i][j] = binary.BigEndian.Uint16(fingerprints[2*(i*bucketSize+j):])
+
+			if decoded.buckets[i][j] != 0 {
+				occupied++
+			}
+		}
+	}
+
+	if occupied != count {
+		return fmt.Errorf("%w: header counts %d values, but %d are stored", ErrInvalidData, count, occupied)
+	}
+
+	*c = decoded
+
+	return nil
+}
+
+// locate returns the fingerprint of v and the index of its first bucket.
+func (c *Cuckoo[T]) locate(v T) (uint16, uint64) {
+	h := hashKey(v)
+
+	fingerprint := uint16(h >> 48) //nolint:gosec // truncation intended
+	if fingerprint == 0 {
+		fingerprint = 1
+	}
+
+	return fingerprint, h & c.mask()
+}
+
+// alternative returns the other bucket of fingerprint stored in the bucket at
+// index. Applied twice, it returns index again.
+func (c *Cuckoo[T]) alternative(index uint64, fingerprint uint16) uint64 {
+	return (index ^ mix(uint64(fingerprint))) & c.mask()
+}
+
+func (c *Cuckoo[T]) mask() uint64 {
+	return uint64(len(c.buckets) - 1)
+}
+
+// insert stores fingerprint in the bucket at index or its alternative,
+// relocating other fingerprints if both are full.
+func (c *Cuckoo[T]) insert(fingerprint uint16, index uint64) error {
+	if c.victim != 0 {
+		return ErrFull
+	}
+
+	alternative := c.alternative(index, fingerprint)
+	if c.buckets[index].insert(fingerprint) || c.buckets[alternative].insert(fingerprint) {
+		c.count++
+
+		return nil
+	}
+
+	// Randomly choosing the starting bucket and the fingerprint to evict
+	// avoids relocation cycles.
+	if rand.IntN(2) == 0 { //nolint:gosec // no security relevance
+		index = alternative
+	}
+
+	for range maxKicks {
+		slot := rand.IntN(bucketSize) //nolint:gosec // no security relevance
+
+		fingerprint, c.buckets[index][slot] = c.buckets[index][slot], fingerprint
+
+		index = c.alternative(index, fingerprint)
+		if c.buckets[index].insert(fingerprint) {
+			c.count++
+
+			return nil
+		}
+	}
+
+	// The value is added, but the evicted fingerprint has no place left.
+	c.victim, c.victimIndex = fingerprint, index
+	c.count++
+
+	return nil
+}
+
+// reinsertVictim tries to store the victim in a bucket, after space was freed.
+func (c *Cuckoo[T]) reinsertVictim() {
+	if c.victim == 0 {
+		return
+	}
+
+	fingerprint, index := c.victim, c.victimIndex
+
+	c.victim = 0
+	c.count--
+
+	// Fails only if there is still no space, then it becomes the victim again.
+	_ = c.insert(fingerprint, index)
+}
+
+func (b *bucket) insert(fingerprint uint16) bool {
+	for i, f := range b {
+		if f == 0 {
+			b[i] = fingerprint
+
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *bucket) contains(fingerprint uint16) bool {
+	for _, f := range b {
+		if f == fingerprint {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (b *bucket) remove(fingerprint uint16) bool {
+	for i, f := range b {
+		if f == fingerprint {
+			b[i] = 0
+
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,204 @@
+package filter_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set/filter"
+)
+
+func ExampleCuckoo() {
+	sessions := filter.NewCuckoo[string](1000)
+
+	_ = sessions.Add("session-1", "session-2")
+	sessions.Remove("session-1")
+
+	fmt.Println(sessions.Contains("session-1"), sessions.Contains("session-2"))
+
+	// Output: false true
+}
+
+func TestCuckoo_falsePositiveRate(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const capacity = 50_000
+
+	c := filter.NewCuckoo[string](capacity)
+	added := ids(0, capacity)
+
+	// Act
+	err := c.Add(added...)
+
+	// Assert
+	require.NoError(t, err)
+	require.True(t, c.Contains(added...), "no false negatives")
+	assert.Equal(t, capacity, c.Len())
+	assert.Greater(t, c.FillRate(), 0.7)
+
+	rate := falsePositiveRate(c.Contains, ids(capacity, 200_000))
+	assert.Less(t, rate, 0.0002)
+}
+
+func TestCuckoo_full(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	c := filter.NewCuckoo[string](100)
+
+	// Act
+	var err error
+
+	added := 0
+	for _, v := range ids(0, 1000) {
+		err = c.Add(v)
+		if err != nil {
+			break
+		}
+
+		added++
+	}
+
+	// Assert
+	require.ErrorIs(t, err, filter.ErrFull)
+	assert.True(t, c.Contains(ids(0, added)...), "no false negatives")
+	assert.Equal(t, added, c.Len())
+	assert.InDelta(t, 1, c.FillRate(), 0.1)
+
+	// Act
+	c.Remove(ids(0, 10)...)
+
+	// Assert
+	require.NoError(t, c.Add("new"))
+	assert.True(t, c.Contains(ids(10, added-10)...), "no false negatives")
+}
+
+func TestCuckoo_Remove(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	c := filter.NewCuckoo[[]byte](10_000)
+
+	for _, v := range ids(0, 5000) {
+		require.NoError(t, c.Add([]byte(v)))
+	}
+
+	// Act
+	for _, v := range ids(0, 2500) {
+		c.Remove([]byte(v))
+	}
+
+	// Assert
+	assert.Equal(t, 2500, c.Len())
+
+	for _, v := range ids(2500, 2500) {
+		require.True(t, c.Contains([]byte(v)), "no false negatives")
+	}
+
+	removedRate := falsePositiveRate(func(values ...string) bool { return c.Contains([]byte(values[0])) }, ids(0, 2500))
+	assert.Less(t, removedRate, 0.01)
+
+	c.Clear()
+	assert.True(t, c.IsEmpty())
+	assert.False(t, c.Contains([]byte(ids(3000, 1)[0])))
+	assert.Panics(t, func() { filter.NewCuckoo[string](0) })
+}
+
+func TestCuckoo_Merge(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	a := filter.NewCuckoo[string](2000)
+	b := filter.NewCuckoo[string](2000)
+	incompatible := filter.NewCuckoo[string](20_000)
+
+	require.NoError(t, a.Add(ids(0, 500)...))
+	require.NoError(t, b.Add(ids(500, 500)...))
+
+	// Act
+	err := a.Merge(b)
+	errIncompatible := a.Merge(incompatible)
+
+	// Assert
+	require.NoError(t, err)
+	require.ErrorIs(t, errIncompatible, filter.ErrIncompatible)
+	assert.True(t, a.Contains(ids(0, 1000)...))
+	assert.Equal(t, 1000, a.Len())
+
+	// Merged values can be removed like added ones.
+	a.Remove(ids(500, 500)...)
+	assert.Equal(t, 500, a.Len())
+	assert.True(t, a.Contains(ids(0, 500)...))
+}
+
+func TestCuckoo_MarshalBinary(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	c := filter.NewCuckoo[string](1000)
+	require.NoError(t, c.Add(ids(0, 900)...))
+
+	// Act
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+
+	var decoded filter.Cuckoo[string]
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, c, &decoded)
+	assert.True(t, decoded.Contains(ids(0, 900)...))
+
+	require.ErrorIs(t, decoded.UnmarshalBinary(data[:len(data)-2]), filter.ErrInvalidData)
+	require.ErrorIs(t, decoded.UnmarshalBinary([]byte("BLM\x01")), filter.ErrInvalidData)
+	require.ErrorIs(t, decoded.UnmarshalBinary(nil), filter.ErrInvalidData)
+}
+
+func TestCuckoo_UnmarshalBinary_overflowingHeader(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	data := []byte("CKO\x01")
+	data = binary.BigEndian.AppendUint64(data, 1<<61)
+	data = binary.BigEndian.AppendUint64(data, 0)
+	data = binary.BigEndian.AppendUint16(data, 0)
+	data = binary.BigEndian.AppendUint64(data, 0)
+
+	var decoded filter.Cuckoo[string]
+
+	// Act
+	err := decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.ErrorIs(t, err, filter.ErrInvalidData)
+}
+
+func TestCuckoo_UnmarshalBinary_countMismatch(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	c := filter.NewCuckoo[string](100)
+	require.NoError(t, c.Add(ids(0, 10)...))
+
+	data, err := c.MarshalBinary()
+	require.NoError(t, err)
+
+	// The count follows the magic and the number of buckets.
+	binary.BigEndian.PutUint64(data[12:], 3)
+
+	decoded := filter.NewCuckoo[string](10)
+	require.NoError(t, decoded.Add("x"))
+
+	// Act
+	err = decoded.UnmarshalBinary(data)
+
+	// Assert
+	require.ErrorIs(t, err, filter.ErrInvalidData)
+	assert.Equal(t, 1, decoded.Len())
+	assert.True(t, decoded.Contains("x"))
+}
@@ -0,0 +1,58 @@
+// Package filter provides probabilistic membership filters, which tell if a
+// value is possibly contained or definitely not contained in a collection,
+// without storing the values themselves.
+//
+// A [Bloom] filter needs less memory for the same false-positive rate, a
+// [Cuckoo] filter additionally supports removing values.
+//
+// Values are hashed deterministically, so marshalled filters can be
+// unmarshalled and merged by other processes.
+package filter
+
+import "errors"
+
+// ErrIncompatible is returned when merging filters with different parameters.
+var ErrIncompatible = errors.New("incompatible filters")
+
+// ErrFull is returned when a value can't be added to a [Cuckoo] filter,
+// because it has no space left.
+var ErrFull = errors.New("filter is full")
+
+// ErrInvalidData is returned when unmarshalling data, which was not created by
+// marshalling a filter of the same kind.
+var ErrInvalidData = errors.New("invalid filter data")
+
+// Key is the type constraint for values of filters. Integers and other values
+// need to be encoded first, e.g. with [strconv.AppendInt].
+type Key interface {
+	~string | ~[]byte
+}
+
+const (
+	fnvOffset = 14695981039346656037
+	fnvPrime  = 1099511628211
+)
+
+// hashKey hashes v with FNV-1a, followed by the finalizer of SplitMix64 for
+// better distribution of the bits.
+func hashKey[T Key](v T) uint64 {
+	hash := uint64(fnvOffset)
+
+	for i := range len(v) {
+		hash ^= uint64(v[i])
+		hash *= fnvPrime
+	}
+
+	return mix(hash)
+}
+
+// mix is the finalizer of SplitMix64.
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+
+	return x
+}
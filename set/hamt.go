@@ -0,0 +1,295 @@
+package set
+
+import (
+	"hash/maphash"
+	"math/bits"
+	"slices"
+)
+
+const (
+	// hamtBits is the number of hash bits consumed per level of the trie.
+	hamtBits = 5
+	// hamtMask selects the hamtBits lowest bits.
+	hamtMask = 1<<hamtBits - 1
+	// hamtMaxShift is the shift from which on the hash is exhausted, so values
+	// with equal hashes are stored in collision nodes.
+	hamtMaxShift = 64
+)
+
+// hamtSeed is shared by all immutable sets, so the trie of a set only depends
+// on its values, which allows comparing sets by their structure.
+//
+//nolint:gochecknoglobals
+var hamtSeed = maphash.MakeSeed()
+
+// hamtEdit identifies the owner of nodes, which may be modified in place. It
+// must not be zero sized, so each allocation has a distinct address.
+type hamtEdit struct {
+	_ byte
+}
+
+// hamtNode is a node of a hash array mapped trie (HAMT) as described by Phil
+// Bagwell.
+//
+// The trie is kept canonical: A child node always holds more than a single
+// value, otherwise the value is stored in the parent directly. Thus the shape
+// of the trie only depends on the contained values.
+type hamtNode[T comparable] struct {
+	// edit is the owner allowed to modify the node in place, nil if the node
+	// belongs to an immutable set.
+	edit *hamtEdit
+	// bitmap has a bit set for each occupied slot, unused by collision nodes.
+	bitmap uint32
+	// entries holds one entry per occupied slot in the order of the slots, or
+	// all values with equal hashes in collision nodes.
+	entries []hamtEntry[T]
+}
+
+// hamtEntry is either a child node, if child is set, or a value with its hash.
+type hamtEntry[T comparable] struct {
+	child *hamtNode[T]
+	hash  uint64
+	value T
+}
+
+func hamtHash[T comparable](v T) uint64 {
+	return maphash.Comparable(hamtSeed, v)
+}
+
+// slot returns the bit of the slot for hash on the level of shift and the
+// index of its entry.
+func (n *hamtNode[T]) slot(hash uint64, shift uint) (uint32, int) {
+	bit := uint32(1) << ((hash >> shift) & hamtMask)
+
+	return bit, bits.OnesCount32(n.bitmap & (bit - 1))
+}
+
+// editable returns n, if it is owned by edit, otherwise a copy owned by edit.
+func (n *hamtNode[T]) editable(edit *hamtEdit) *hamtNode[T] {
+	if edit != nil && n.edit == edit {
+		return n
+	}
+
+	return &hamtNode[T]{edit: edit, bitmap: n.bitmap, entries: slices.Clone(n.entries)}
+}
+
+// contains checks if the trie rooted at n contains v.
+func (n *hamtNode[T]) contains(hash uint64, v T) bool {
+	for shift := uint(0); n != nil; shift += hamtBits {
+		if shift >= hamtMaxShift {
+			return slices.ContainsFunc(n.entries, func(e hamtEntry[T]) bool { return e.value == v })
+		}
+
+		bit, i := n.slot(hash, shift)
+		if n.bitmap&bit == 0 {
+			return false
+		}
+
+		e := n.entries[i]
+		if e.child == nil {
+			return e.hash == hash && e.value == v
+		}
+
+		n = e.child
+	}
+
+	return false
+}
+
+// insert returns the trie rooted at n with v added and whether v was added.
+// Nodes owned by edit are modified in place, all others are copied.
+func (n *hamtNode[T]) insert(shift uint, hash uint64, v T, edit *hamtEdit) (*hamtNode[T], bool) {
+	leaf := hamtEntry[T]{hash: hash, value: v}
+
+	if n == nil {
+		bit := uint32(1) << ((hash >> shift) & hamtMask)
+
+		return &hamtNode[T]{edit: edit, bitmap: bit, entries: []hamtEntry[T]{leaf}}, true
+	}
+
+	if shift >= hamtMaxShift {
+		if slices.ContainsFunc(n.entries, func(e hamtEntry[T]) bool { return e.value == v }) {
+			return n, false
+		}
+
+		edited := n.editable(edit)
+		edited.entries = append(edited.entries, leaf)
+
+		return edited, true
+	}
+
+	bit, i := n.slot(hash, shift)
+	if n.bitmap&bit == 0 {
+		edited := n.editable(edit)
+		edited.bitmap |= bit
+		edited.entries = slices.Insert(edited.entries, i, leaf)
+
+		return edited, true
+	}
+
+	e := n.entries[i]
+
+	switch {
+	case e.child != nil:
+		child, added := e.child.insert(shift+hamtBits, hash, v, edit)
+		if !added {
+			return n, false
+		}
+
+		edited := n.editable(edit)
+		edited.entries[i].child = child
+
+		return edited, true
+	case e.hash == hash && e.value == v:
+		return n, false
+	default:
+		edited := n.editable(edit)
+		edited.entries[i] = hamtEntry[T]{child: mergeLeaves(shift+hamtBits, e, leaf, edit)}
+
+		return edited, true
+	}
+}
+
+// mergeLeaves creates the node holding the leaves a and b on the level of
+// shift.
+func mergeLeaves[T comparable](shift uint, a, b hamtEntry[T], edit *hamtEdit) *hamtNode[T] {
+	if shift >= hamtMaxShift {
+		return &hamtNode[T]{edit: edit, entries: []hamtEntry[T]{a, b}}
+	}
+
+	slotA, slotB := (a.hash>>shift)&hamtMask, (b.hash>>shift)&hamtMask
+
+	switch {
+	case slotA == slotB:
+		child := hamtEntry[T]{child: mergeLeaves(shift+hamtBits, a, b, edit)}
+
+		return &hamtNode[T]{edit: edit, bitmap: 1 << slotA, entries: []hamtEntry[T]{child}}
+	case slotA > slotB:
+		a, b = b, a
+	}
+
+	return &hamtNode[T]{edit: edit, bitmap: 1<<slotA | 1<<slotB, entries: []hamtEntry[T]{a, b}}
+}
+
+// remove returns the trie rooted at n without v and whether v was removed.
+// Nodes owned by edit are modified in place, all others are copied.
+func (n *hamtNode[T]) remove(shift uint, hash uint64, v T, edit *hamtEdit) (*hamtNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	if shift >= hamtMaxShift {
+		i := slices.IndexFunc(n.entries, func(e hamtEntry[T]) bool { return e.value == v })
+		if i < 0 {
+			return n, false
+		}
+
+		edited := n.editable(edit)
+		edited.entries = slices.Delete(edited.entries, i, i+1)
+
+		return edited, true
+	}
+
+	bit, i := n.slot(hash, shift)
+	if n.bitmap&bit == 0 {
+		return n, false
+	}
+
+	e := n.entries[i]
+
+	if e.child == nil {
+		if e.hash != hash || e.value != v {
+			return n, false
+		}
+
+		edited := n.editable(edit)
+		edited.bitmap &^= bit
+		edited.entries = slices.Delete(edited.entries, i, i+1)
+
+		return edited, true
+	}
+
+	child, removed := e.child.remove(shift+hamtBits, hash, v, edit)
+	if !removed {
+		return n, false
+	}
+
+	edited := n.editable(edit)
+
+	// Keep the trie canonical by moving a single remaining value up.
+	if len(child.entries) == 1 && child.entries[0].child == nil {
+		edited.entries[i] = child.entries[0]
+	} else {
+		edited.entries[i].child = child
+	}
+
+	return edited, true
+}
+
+// all yields all values of the trie rooted at n and returns false, if yield
+// returned false.
+func (n *hamtNode[T]) all(yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+
+	for _, e := range n.entries {
+		if e.child != nil {
+			if !e.child.all(yield) {
+				return false
+			}
+
+			continue
+		}
+
+		if !yield(e.value) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// equal checks if the tries rooted at n and other contain the same values. As
+// both are canonical, they are compared by structure, skipping shared nodes.
+func (n *hamtNode[T]) equal(other *hamtNode[T], shift uint) bool {
+	switch {
+	case n == other:
+		return true
+	case n == nil || other == nil || len(n.entries) != len(other.entries):
+		return false
+	case shift >= hamtMaxShift:
+		// Values of collision nodes are stored in the order they were added.
+		for _, e := range n.entries {
+			if !slices.ContainsFunc(other.entries, func(o hamtEntry[T]) bool { return o.value == e.value }) {
+				return false
+			}
+		}
+
+		return true
+	case n.bitmap != other.bitmap:
+		return false
+	}
+
+	for i, e := range n.entries {
+		o := other.entries[i]
+
+		if (e.child == nil) != (o.child == nil) {
+			return false
+		}
+
+		if e.child == nil {
+			if e.hash != o.hash || e.value != o.value {
+				return false
+			}
+
+			continue
+		}
+
+		if !e.child.equal(o.child, shift+hamtBits) {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,51 @@
+package set
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestHamtNode_collisions covers values with equal or similar hashes, which
+// can't be created through the public API.
+func TestHamtNode_collisions(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	const (
+		hash      = uint64(0xABCDEF0123456789)
+		colliding = hash ^ 1<<63
+	)
+
+	var root *hamtNode[string]
+
+	// Act
+	root, _ = root.insert(0, hash, "a", nil)
+	root, _ = root.insert(0, hash, "b", nil)
+	root, _ = root.insert(0, hash, "c", nil)
+	withColliding, _ := root.insert(0, colliding, "d", nil)
+	_, addedAgain := withColliding.insert(0, hash, "b", nil)
+
+	// Assert
+	assert.False(t, addedAgain)
+	assert.True(t, withColliding.contains(hash, "a"))
+	assert.True(t, withColliding.contains(colliding, "d"))
+	assert.False(t, withColliding.contains(hash, "d"))
+	assert.False(t, root.contains(colliding, "d"))
+
+	removed, _ := withColliding.remove(0, colliding, "d", nil)
+	assert.True(t, removed.equal(root, 0))
+
+	reordered, _ := (*hamtNode[string])(nil).insert(0, hash, "c", nil)
+	reordered, _ = reordered.insert(0, hash, "a", nil)
+	reordered, _ = reordered.insert(0, hash, "b", nil)
+	assert.True(t, reordered.equal(root, 0))
+
+	// Removing colliding values must collapse the trie to a single value.
+	single, _ := root.remove(0, hash, "a", nil)
+	single, _ = single.remove(0, hash, "c", nil)
+	expect, _ := (*hamtNode[string])(nil).insert(0, hash, "b", nil)
+	assert.True(t, single.equal(expect, 0))
+	assert.Len(t, single.entries, 1)
+	assert.Nil(t, single.entries[0].child)
+}
@@ -0,0 +1,166 @@
+package set
+
+import (
+	"fmt"
+	"hash/maphash"
+	"maps"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Hasher defines how values of a [HashSet] are hashed and compared.
+//
+// Values which are equal according to Equal must have the same hash. Equal
+// must be an equivalence relation, i.e. reflexive, symmetric and transitive.
+// The fewer different values have the same hash, the faster the set.
+type Hasher[T any] interface {
+	Hash(v T) uint64
+	Equal(a, b T) bool
+}
+
+// ComparableHasher creates a [Hasher] comparing values with == and hashing
+// them like keys of a map.
+func ComparableHasher[T comparable]() Hasher[T] {
+	return comparableHasher[T]{seed: maphash.MakeSeed()}
+}
+
+// FoldHasher creates a [Hasher] for strings, which ignores the case of
+// letters, so e.g. "Go", "GO" and "go" are equal.
+func FoldHasher() Hasher[string] {
+	return foldHasher{seed: maphash.MakeSeed()}
+}
+
+// SliceHasher creates a [Hasher] for slices, which are equal if they have the
+// same length and equal elements in the same order, like in [slices.Equal].
+func SliceHasher[E comparable]() Hasher[[]E] {
+	return sliceHasher[E]{seed: maphash.MakeSeed()}
+}
+
+// MapHasher creates a [Hasher] for maps, which are equal if they contain the
+// same key value pairs, like in [maps.Equal].
+func MapHasher[K, V comparable]() Hasher[map[K]V] {
+	return mapHasher[K, V]{seed: maphash.MakeSeed()}
+}
+
+// FloatHasher creates a [Hasher] for floats, which are equal if they round to
+// the same multiple of precision. E.g. with a precision of 0.01, 1.004 and
+// 0.996 are equal, but 1.004 and 1.006 are not.
+//
+// Like in maps, NaN is not equal to any value, including itself.
+//
+// Panics if precision is not a positive finite number.
+func FloatHasher(precision float64) Hasher[float64] {
+	if !(precision > 0) || math.IsInf(precision, 1) {
+		panic(fmt.Sprintf("precision must be positive and finite, but was %v", precision))
+	}
+
+	return floatHasher{seed: maphash.MakeSeed(), precision: precision}
+}
+
+// FuncHasher creates a [Hasher] from the given hash and equal functions.
+func FuncHasher[T any](hash func(v T) uint64, equal func(a, b T) bool) Hasher[T] {
+	return funcHasher[T]{hash: hash, equal: equal}
+}
+
+type comparableHasher[T comparable] struct {
+	seed maphash.Seed
+}
+
+func (h comparableHasher[T]) Hash(v T) uint64 {
+	return maphash.Comparable(h.seed, v)
+}
+
+func (comparableHasher[T]) Equal(a, b T) bool {
+	return a == b
+}
+
+type foldHasher struct {
+	seed maphash.Seed
+}
+
+// Hash hashes the lower case string. It is consistent with Equal, unlike
+// [strings.EqualFold], which considers some differently lowered runes equal.
+func (h foldHasher) Hash(v string) uint64 {
+	return maphash.String(h.seed, strings.ToLower(v))
+}
+
+func (foldHasher) Equal(a, b string) bool {
+	return a == b || strings.ToLower(a) == strings.ToLower(b)
+}
+
+type sliceHasher[E comparable] struct {
+	seed maphash.Seed
+}
+
+func (h sliceHasher[E]) Hash(v []E) uint64 {
+	var hash maphash.Hash
+
+	hash.SetSeed(h.seed)
+
+	for _, e := range v {
+		maphash.WriteComparable(&hash, e)
+	}
+
+	return hash.Sum64()
+}
+
+func (sliceHasher[E]) Equal(a, b []E) bool {
+	return slices.Equal(a, b)
+}
+
+type mapHasher[K, V comparable] struct {
+	seed maphash.Seed
+}
+
+// Hash combines the hashes of all pairs by addition, so it does not depend on
+// the iteration order.
+func (h mapHasher[K, V]) Hash(v map[K]V) uint64 {
+	var sum uint64
+
+	for key, value := range v {
+		var hash maphash.Hash
+
+		hash.SetSeed(h.seed)
+		maphash.WriteComparable(&hash, key)
+		maphash.WriteComparable(&hash, value)
+
+		sum += hash.Sum64()
+	}
+
+	return sum
+}
+
+func (mapHasher[K, V]) Equal(a, b map[K]V) bool {
+	return maps.Equal(a, b)
+}
+
+type floatHasher struct {
+	seed      maphash.Seed
+	precision float64
+}
+
+func (h floatHasher) Hash(v float64) uint64 {
+	return maphash.Comparable(h.seed, h.round(v))
+}
+
+func (h floatHasher) Equal(a, b float64) bool {
+	return h.round(a) == h.round(b)
+}
+
+func (h floatHasher) round(v float64) float64 {
+	return math.Round(v / h.precision)
+}
+
+type funcHasher[T any] struct {
+	hash  func(v T) uint64
+	equal func(a, b T) bool
+}
+
+func (h funcHasher[T]) Hash(v T) uint64 {
+	return h.hash(v)
+}
+
+func (h funcHasher[T]) Equal(a, b T) bool {
+	return h.equal(a, b)
+}
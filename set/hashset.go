@@ -0,0 +1,252 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"slices"
+	"sort"
+)
+
+// HashSet implements a collection of unique, unordered values of any type,
+// which are hashed and compared by a [Hasher].
+//
+// It provides the same methods as [Set], but can also hold values, which are
+// not comparable, like slices or maps, or which are compared differently than
+// by ==, like case-insensitive strings (see [FoldHasher]). When adding a value
+// equal to a contained one, the contained value is kept.
+//
+// Like a [Set], a HashSet references its values, so copies of a HashSet share
+// them, and it is not thread-safe.
+type HashSet[T any] struct {
+	table *hashTable[T]
+}
+
+// hashTable stores the values in buckets by their hash.
+type hashTable[T any] struct {
+	hasher  Hasher[T]
+	buckets map[uint64][]T
+	length  int
+}
+
+// HashSetOf creates a new hash set using hasher with the given values.
+func HashSetOf[T any](hasher Hasher[T], values ...T) HashSet[T] {
+	s := HashSet[T]{table: &hashTable[T]{hasher: hasher, buckets: make(map[uint64][]T, len(values))}}
+
+	s.Add(values...)
+
+	return s
+}
+
+// Add adds the given values to the set if they are not already present.
+func (s HashSet[T]) Add(values ...T) {
+	for _, v := range values {
+		hash := s.table.hasher.Hash(v)
+
+		bucket := s.table.buckets[hash]
+		if s.table.indexIn(bucket, v) >= 0 {
+			continue
+		}
+
+		s.table.buckets[hash] = append(bucket, v)
+		s.table.length++
+	}
+}
+
+// Remove removes the given values from the set.
+func (s HashSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		hash := s.table.hasher.Hash(v)
+
+		bucket := s.table.buckets[hash]
+
+		i := s.table.indexIn(bucket, v)
+		if i < 0 {
+			continue
+		}
+
+		if len(bucket) == 1 {
+			delete(s.table.buckets, hash)
+		} else {
+			s.table.buckets[hash] = slices.Delete(bucket, i, i+1)
+		}
+
+		s.table.length--
+	}
+}
+
+// Clear removes all values from the set.
+func (s HashSet[T]) Clear() {
+	clear(s.table.buckets)
+	s.table.length = 0
+}
+
+// Values returns a slice of all values in the set without any particular
+// order.
+func (s HashSet[T]) Values() []T {
+	values := make([]T, 0, s.table.length)
+
+	for _, bucket := range s.table.buckets {
+		values = append(values, bucket...)
+	}
+
+	return values
+}
+
+// All creates an iterator over all values in the set without any particular
+// order.
+func (s HashSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, bucket := range s.table.buckets {
+			for _, v := range bucket {
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// Len returns the number of values in the set.
+func (s HashSet[T]) Len() int {
+	return s.table.length
+}
+
+// IsEmpty returns true if the set is empty.
+func (s HashSet[T]) IsEmpty() bool {
+	return s.table.length == 0
+}
+
+// Clone creates a shallow copy of the set using the same [Hasher].
+func (s HashSet[T]) Clone() HashSet[T] {
+	clone := HashSet[T]{table: &hashTable[T]{
+		hasher:  s.table.hasher,
+		buckets: make(map[uint64][]T, len(s.table.buckets)),
+		length:  s.table.length,
+	}}
+
+	for hash, bucket := range s.table.buckets {
+		clone.table.buckets[hash] = slices.Clone(bucket)
+	}
+
+	return clone
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(HashSet[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(HashSet[{{type}}]: <empty>)"
+//
+// The values are sorted by their string representation for easier overview,
+// the actual set is not sorted.
+func (s HashSet[T]) String() string {
+	if s.IsEmpty() {
+		return fmt.Sprintf("(HashSet[%T]: <empty>)", *new(T))
+	}
+
+	values := make([]string, 0, s.table.length)
+	for v := range s.All() {
+		values = append(values, fmt.Sprintf("%v", v))
+	}
+
+	sort.Strings(values)
+
+	return fmt.Sprintf("(HashSet[%T]: %s)", *new(T), values)
+}
+
+// Contains checks if the set contains all the given values.
+func (s HashSet[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		if !s.table.contains(v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsExactly checks if the set contains all the given values and no more.
+// Like in [Set.ContainsExactly], values must not be given twice.
+func (s HashSet[T]) ContainsExactly(values ...T) bool {
+	if s.table.length != len(values) {
+		return false
+	}
+
+	return s.Contains(values...)
+}
+
+// Union adds all values from the given sets to the current set.
+func (s HashSet[T]) Union(others ...HashSet[T]) {
+	for _, other := range others {
+		if other.table == s.table {
+			continue
+		}
+
+		for v := range other.All() {
+			s.Add(v)
+		}
+	}
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets.
+func (s HashSet[T]) Intersection(others ...HashSet[T]) {
+	for _, v := range s.Values() {
+		for _, other := range others {
+			if !other.Contains(v) {
+				s.Remove(v)
+
+				break
+			}
+		}
+	}
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets.
+func (s HashSet[T]) Difference(others ...HashSet[T]) {
+	for _, other := range others {
+		if other.table == s.table {
+			s.Clear()
+
+			return
+		}
+
+		for v := range other.All() {
+			s.Remove(v)
+		}
+	}
+}
+
+// Unique modifies the set, to only contain values which appear only in one set,
+// including the set itself and all given other sets.
+//
+// The values are compared by the [Hasher] of the set.
+func (s HashSet[T]) Unique(others ...HashSet[T]) {
+	if len(others) == 0 {
+		return
+	}
+
+	// seen contains all values appearing in any set, duplicates the ones
+	// appearing in more than one.
+	seen, duplicates := s.Clone(), HashSetOf(s.table.hasher)
+
+	for _, other := range others {
+		for v := range other.All() {
+			if seen.Contains(v) {
+				duplicates.Add(v)
+			} else {
+				seen.Add(v)
+			}
+		}
+	}
+
+	seen.Difference(duplicates)
+	*s.table = *seen.table
+}
+
+func (t *hashTable[T]) contains(v T) bool {
+	return t.indexIn(t.buckets[t.hasher.Hash(v)], v) >= 0
+}
+
+func (t *hashTable[T]) indexIn(bucket []T, v T) int {
+	return slices.IndexFunc(bucket, func(e T) bool { return t.hasher.Equal(e, v) })
+}
@@ -0,0 +1,233 @@
+package set_test
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+var _ collection[int] = set.HashSet[int]{}
+
+func ExampleHashSet() {
+	tags := set.HashSetOf(set.FoldHasher(), "Go", "golang", "GO", "Rust")
+
+	fmt.Println(tags.Len(), tags.Contains("go", "rust"))
+	fmt.Println(tags)
+
+	// Output:
+	// 3 true
+	// (HashSet[string]: [Go Rust golang])
+}
+
+func ExampleSliceHasher() {
+	paths := set.HashSetOf(set.SliceHasher[string](),
+		[]string{"usr", "bin"},
+		[]string{"usr", "lib"},
+		[]string{"usr", "bin"},
+	)
+
+	fmt.Println(paths.Len(), paths.Contains([]string{"usr", "lib"}))
+
+	// Output: 2 true
+}
+
+// degenerateHasher hashes all values to the same hash, so every value collides.
+func degenerateHasher() set.Hasher[int] {
+	return set.FuncHasher(func(int) uint64 { return 42 }, func(a, b int) bool { return a == b })
+}
+
+func TestHashSet_collisions(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(17))
+
+	s := set.HashSetOf(degenerateHasher())
+	reference := set.Of[int]()
+
+	for range 3000 {
+		// Act
+		v := rand.Intn(200)
+
+		if rand.Intn(3) == 0 {
+			s.Remove(v)
+			reference.Remove(v)
+		} else {
+			s.Add(v)
+			reference.Add(v)
+		}
+
+		// Assert
+		require.Equal(t, reference.Len(), s.Len())
+		require.Equal(t, reference.Contains(v), s.Contains(v))
+	}
+
+	assert.ElementsMatch(t, reference.Values(), s.Values())
+
+	s.Clear()
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, "(HashSet[int]: <empty>)", s.String())
+}
+
+//nolint:funlen
+func TestHashSet_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(s set.HashSet[int], others ...set.HashSet[int])
+		reference func(s set.Set[int], others ...set.Set[int])
+	}
+
+	tests := []test{
+		{"union", set.HashSet[int].Union, set.Set[int].Union},
+		{"intersection", set.HashSet[int].Intersection, set.Set[int].Intersection},
+		{"difference", set.HashSet[int].Difference, set.Set[int].Difference},
+		{"unique", set.HashSet[int].Unique, set.Set[int].Unique},
+	}
+
+	hashers := map[string]set.Hasher[int]{
+		"comparable": set.ComparableHasher[int](),
+		"degenerate": degenerateHasher(),
+	}
+
+	values := [][]int{{1, 2, 3, 4, 5, 6}, {2, 4, 6, 8}, {4, 5, 6, 7}}
+
+	for _, tc := range tests {
+		for hasherName, hasher := range hashers {
+			t.Run(tc.name+" "+hasherName, func(t *testing.T) {
+				t.Parallel()
+
+				// Arrange
+				hashSets := make([]set.HashSet[int], len(values))
+				reference := make([]set.Set[int], len(values))
+
+				for i, v := range values {
+					hashSets[i] = set.HashSetOf(hasher, v...)
+					reference[i] = set.Of(v...)
+				}
+
+				// Act
+				tc.operation(hashSets[0], hashSets[1:]...)
+				tc.reference(reference[0], reference[1:]...)
+
+				// Assert
+				assert.ElementsMatch(t, reference[0].Values(), hashSets[0].Values())
+				assert.Equal(t, reference[0].Len(), hashSets[0].Len())
+				assert.ElementsMatch(t, values[1], hashSets[1].Values(), "others must not be modified")
+			})
+		}
+	}
+}
+
+func TestHashSet_Clone(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.HashSetOf(degenerateHasher(), 1, 2, 3)
+
+	// Act
+	clone := s.Clone()
+	clone.Remove(2)
+	clone.Add(4)
+
+	// Assert
+	assert.True(t, s.ContainsExactly(1, 2, 3))
+	assert.True(t, clone.ContainsExactly(1, 3, 4))
+}
+
+func TestHashSet_operationsWithItself(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.HashSetOf(set.ComparableHasher[int](), 1, 2, 3)
+
+	// Act & Assert
+	s.Union(s)
+	s.Intersection(s)
+	assert.True(t, s.ContainsExactly(1, 2, 3))
+
+	unique := s.Clone()
+	unique.Unique(unique)
+	assert.True(t, unique.IsEmpty())
+
+	s.Difference(s)
+	assert.True(t, s.IsEmpty())
+}
+
+//nolint:funlen
+func TestHashers(t *testing.T) {
+	t.Parallel()
+
+	stringTests := []hasherTest[string]{
+		{"fold equal", set.FoldHasher(), "Straße", "STRASSE", false},
+		{"fold upper lower", set.FoldHasher(), "Gopher", "gOPHER", true},
+		{"fold different", set.FoldHasher(), "go", "gp", false},
+		{"comparable equal", set.ComparableHasher[string](), "a", "a", true},
+		{"comparable case", set.ComparableHasher[string](), "a", "A", false},
+	}
+
+	sliceTests := []hasherTest[[]int]{
+		{"nil and empty", set.SliceHasher[int](), nil, []int{}, true},
+		{"equal", set.SliceHasher[int](), []int{1, 2}, []int{1, 2}, true},
+		{"different order", set.SliceHasher[int](), []int{1, 2}, []int{2, 1}, false},
+		{"prefix", set.SliceHasher[int](), []int{1, 2}, []int{1, 2, 0}, false},
+	}
+
+	mapTests := []hasherTest[map[string]int]{
+		{"nil and empty", set.MapHasher[string, int](), nil, map[string]int{}, true},
+		{"equal", set.MapHasher[string, int](), map[string]int{"a": 1, "b": 2}, map[string]int{"b": 2, "a": 1}, true},
+		{"different value", set.MapHasher[string, int](), map[string]int{"a": 1}, map[string]int{"a": 2}, false},
+		{"swapped values", set.MapHasher[string, int](), map[string]int{"a": 1, "b": 2}, map[string]int{"a": 2, "b": 1}, false},
+	}
+
+	floatTests := []hasherTest[float64]{
+		{"within precision", set.FloatHasher(0.01), 1.004, 0.996, true},
+		{"different", set.FloatHasher(0.01), 1.004, 1.006, false},
+		{"zero signs", set.FloatHasher(0.01), math.Copysign(0, -1), 0.001, true},
+		{"infinity", set.FloatHasher(0.01), math.Inf(1), math.Inf(1), true},
+		{"NaN", set.FloatHasher(0.01), math.NaN(), math.NaN(), false},
+	}
+
+	runHasherTests(t, stringTests)
+	runHasherTests(t, sliceTests)
+	runHasherTests(t, mapTests)
+	runHasherTests(t, floatTests)
+
+	assert.Panics(t, func() { set.FloatHasher(0) })
+	assert.Panics(t, func() { set.FloatHasher(math.NaN()) })
+	assert.Panics(t, func() { set.FloatHasher(math.Inf(1)) })
+}
+
+type hasherTest[T any] struct {
+	name   string
+	hasher set.Hasher[T]
+	a, b   T
+	expect bool
+}
+
+// runHasherTests checks if the values are equal as expected and if equal
+// values have equal hashes.
+func runHasherTests[T any](t *testing.T, tests []hasherTest[T]) {
+	t.Helper()
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expect, tc.hasher.Equal(tc.a, tc.b))
+			assert.Equal(t, tc.expect, tc.hasher.Equal(tc.b, tc.a))
+
+			if tc.expect {
+				assert.Equal(t, tc.hasher.Hash(tc.a), tc.hasher.Hash(tc.b))
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"sort"
+)
+
+// Immutable implements a persistent collection of unique, unordered values.
+//
+// Methods modifying the set, like [Immutable.Add], return a new set and leave
+// the original unchanged. The new set shares all unchanged parts with the
+// original, so a modification takes O(log n) time and memory instead of
+// copying all values like [Set.Clone]. This makes it suitable for keeping many
+// versions of a set, e.g. snapshots of a configuration.
+//
+// It is backed by a hash array mapped trie, whose shape only depends on the
+// contained values, so [Immutable.Equal] can skip parts shared by both sets.
+//
+// For adding or removing many values at once, use an [ImmutableBuilder], which
+// modifies its nodes in place as long as they are not shared with any set.
+//
+// The zero value is an empty set. As it never changes, an Immutable can be
+// shared between goroutines without synchronization.
+type Immutable[T comparable] struct {
+	root *hamtNode[T]
+	size int
+}
+
+// ImmutableOf creates a new immutable set with the given values.
+func ImmutableOf[T comparable](values ...T) Immutable[T] {
+	var b ImmutableBuilder[T]
+
+	b.Add(values...)
+
+	return b.Immutable()
+}
+
+// ImmutableFrom creates a new immutable set with the values produced by seq,
+// e.g. from a [Set] with ImmutableFrom(s.All()).
+func ImmutableFrom[T comparable](seq iter.Seq[T]) Immutable[T] {
+	var b ImmutableBuilder[T]
+
+	for v := range seq {
+		b.Add(v)
+	}
+
+	return b.Immutable()
+}
+
+// Add returns a set with the values of s and the given values.
+func (s Immutable[T]) Add(values ...T) Immutable[T] {
+	b := s.Builder()
+
+	b.Add(values...)
+
+	return b.Immutable()
+}
+
+// Remove returns a set with the values of s except the given values.
+func (s Immutable[T]) Remove(values ...T) Immutable[T] {
+	b := s.Builder()
+
+	b.Remove(values...)
+
+	return b.Immutable()
+}
+
+// Contains checks if the set contains all the given values.
+func (s Immutable[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		if !s.root.contains(hamtHash(v), v) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Len returns the number of values in the set.
+func (s Immutable[T]) Len() int {
+	return s.size
+}
+
+// IsEmpty returns true if the set is empty.
+func (s Immutable[T]) IsEmpty() bool {
+	return s.size == 0
+}
+
+// Values returns a slice of all values in the set without any particular
+// order.
+func (s Immutable[T]) Values() []T {
+	values := make([]T, 0, s.size)
+
+	s.root.all(func(v T) bool {
+		values = append(values, v)
+
+		return true
+	})
+
+	return values
+}
+
+// All creates an iterator over all values in the set without any particular
+// order.
+func (s Immutable[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.all(yield)
+	}
+}
+
+// Equal checks if both sets contain exactly the same values. Parts shared by
+// both sets, e.g. because one was derived from the other, are not compared.
+func (s Immutable[T]) Equal(other Immutable[T]) bool {
+	return s.size == other.size && s.root.equal(other.root, 0)
+}
+
+// ToSet creates a [Set] with the values of the set.
+func (s Immutable[T]) ToSet() Set[T] {
+	result := WithCapacity[T](s.size)
+
+	s.root.all(func(v T) bool {
+		result.keySetMap[v] = placeholder
+
+		return true
+	})
+
+	return result
+}
+
+// Builder creates an [ImmutableBuilder] starting with the values of the set.
+func (s Immutable[T]) Builder() *ImmutableBuilder[T] {
+	return &ImmutableBuilder[T]{root: s.root, size: s.size}
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(Immutable[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(Immutable[{{type}}]: <empty>)"
+//
+// The values are sorted by their string representation for easier overview.
+func (s Immutable[T]) String() string {
+	if s.IsEmpty() {
+		return fmt.Sprintf("(Immutable[%T]: <empty>)", *new(T))
+	}
+
+	values := make([]string, 0, s.size)
+	for v := range s.All() {
+		values = append(values, fmt.Sprintf("%v", v))
+	}
+
+	sort.Strings(values)
+
+	return fmt.Sprintf("(Immutable[%T]: %s)", *new(T), values)
+}
+
+// ImmutableBuilder is a mutable, transient version of an [Immutable] set for
+// efficiently adding or removing many values.
+//
+// Nodes created by the builder are modified in place, while nodes shared with
+// an [Immutable] are copied on first modification. [ImmutableBuilder.Immutable]
+// returns the current values as [Immutable], the builder can still be used
+// afterward without affecting it.
+//
+// The zero value is an empty builder. It is not thread-safe.
+type ImmutableBuilder[T comparable] struct {
+	root *hamtNode[T]
+	size int
+	edit *hamtEdit
+}
+
+// Add adds the given values to the builder if they are not already present.
+func (b *ImmutableBuilder[T]) Add(values ...T) {
+	for _, v := range values {
+		root, added := b.root.insert(0, hamtHash(v), v, b.owner())
+		if added {
+			b.root = root
+			b.size++
+		}
+	}
+}
+
+// Remove removes the given values from the builder.
+func (b *ImmutableBuilder[T]) Remove(values ...T) {
+	for _, v := range values {
+		root, removed := b.root.remove(0, hamtHash(v), v, b.owner())
+		if !removed {
+			continue
+		}
+
+		b.root = root
+		b.size--
+
+		if b.size == 0 {
+			b.root = nil
+		}
+	}
+}
+
+// Contains checks if the builder contains all the given values.
+func (b *ImmutableBuilder[T]) Contains(values ...T) bool {
+	return Immutable[T]{root: b.root, size: b.size}.Contains(values...)
+}
+
+// Len returns the number of values in the builder.
+func (b *ImmutableBuilder[T]) Len() int {
+	return b.size
+}
+
+// Immutable returns an [Immutable] set with the current values of the builder.
+func (b *ImmutableBuilder[T]) Immutable() Immutable[T] {
+	// Further modifications must not change the nodes shared with the set.
+	b.edit = nil
+
+	return Immutable[T]{root: b.root, size: b.size}
+}
+
+// owner returns the edit owning the nodes modifiable in place.
+func (b *ImmutableBuilder[T]) owner() *hamtEdit {
+	if b.edit == nil {
+		b.edit = &hamtEdit{}
+	}
+
+	return b.edit
+}
@@ -0,0 +1,178 @@
+package set_test
+
+import (
+	"fmt"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleImmutable() {
+	v1 := set.ImmutableOf("debug", "metrics")
+	v2 := v1.Add("tracing")
+	v3 := v2.Remove("debug")
+
+	fmt.Println(v1)
+	fmt.Println(v2)
+	fmt.Println(v3)
+	fmt.Println(v3.Equal(set.ImmutableOf("metrics", "tracing")))
+
+	// Output:
+	// (Immutable[string]: [debug metrics])
+	// (Immutable[string]: [debug metrics tracing])
+	// (Immutable[string]: [metrics tracing])
+	// true
+}
+
+func ExampleImmutableBuilder() {
+	var b set.ImmutableBuilder[int]
+
+	for i := range 1000 {
+		b.Add(i)
+	}
+
+	s := b.Immutable()
+
+	b.Remove(0)
+
+	fmt.Println(s.Len(), s.Contains(0))
+	fmt.Println(b.Len(), b.Contains(0))
+
+	// Output:
+	// 1000 true
+	// 999 false
+}
+
+func TestImmutable_zeroValue(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	var s set.Immutable[string]
+
+	// Act
+	added := s.Add("a")
+
+	// Assert
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, "(Immutable[string]: <empty>)", s.String())
+	assert.False(t, s.Contains("a"))
+	assert.Empty(t, s.Values())
+	assert.True(t, s.Equal(set.ImmutableOf[string]()))
+	assert.True(t, s.Equal(added.Remove("a")))
+	assert.True(t, added.Contains("a"))
+}
+
+//nolint:funlen
+func TestImmutable_randomOperations(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(3))
+
+	type version struct {
+		s      set.Immutable[int]
+		expect []int
+	}
+
+	var versions []version
+
+	s := set.Immutable[int]{}
+	reference := set.Of[int]()
+
+	for i := range 3000 {
+		// Act
+		v := rand.Intn(1000)
+
+		if rand.Intn(3) == 0 {
+			s = s.Remove(v)
+			reference.Remove(v)
+		} else {
+			s = s.Add(v)
+			reference.Add(v)
+		}
+
+		if i%100 == 0 {
+			versions = append(versions, version{s, reference.Values()})
+		}
+
+		// Assert
+		require.Equal(t, reference.Len(), s.Len())
+		require.Equal(t, reference.Contains(v), s.Contains(v))
+	}
+
+	assert.ElementsMatch(t, reference.Values(), s.Values())
+	assert.True(t, reference.Equal(s.ToSet()))
+
+	for _, version := range versions {
+		assert.ElementsMatch(t, version.expect, version.s.Values(), "older versions must not change")
+		assert.True(t, version.s.Equal(set.ImmutableOf(version.expect...)))
+	}
+}
+
+func TestImmutable_Equal(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	values := make([]int, 500)
+	for i := range values {
+		values[i] = i
+	}
+
+	s := set.ImmutableOf(values...)
+	backward := slices.Clone(values)
+	slices.Reverse(backward)
+
+	reversed := set.ImmutableOf(backward...)
+
+	// Assert
+	assert.True(t, s.Equal(reversed))
+	assert.True(t, s.Equal(s.Add(7)))
+	assert.True(t, s.Equal(s.Remove(1000)))
+	assert.True(t, s.Equal(s.Add(1000).Remove(1000)))
+	assert.False(t, s.Equal(s.Remove(7)))
+	assert.False(t, s.Equal(s.Remove(7).Add(1000)))
+	assert.False(t, s.Equal(set.Immutable[int]{}))
+}
+
+func TestImmutableBuilder(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	original := set.ImmutableOf(1, 2, 3)
+	b := original.Builder()
+
+	// Act
+	b.Add(4, 5, 1)
+	first := b.Immutable()
+	b.Remove(1, 2, 42)
+	second := b.Immutable()
+	b.Remove(3, 4, 5)
+
+	// Assert
+	assert.True(t, original.ToSet().ContainsExactly(1, 2, 3))
+	assert.True(t, first.ToSet().ContainsExactly(1, 2, 3, 4, 5))
+	assert.True(t, second.ToSet().ContainsExactly(3, 4, 5))
+	assert.True(t, b.Immutable().IsEmpty())
+	assert.Equal(t, 0, b.Len())
+}
+
+func TestImmutableFrom(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of("a", "b", "c")
+
+	// Act
+	immutable := set.ImmutableFrom(s.All())
+
+	// Assert
+	assert.True(t, immutable.Equal(set.ImmutableOf("c", "b", "a")))
+	assert.True(t, s.Equal(immutable.ToSet()))
+	assert.True(t, s.EqualSeq(immutable.All()))
+}
@@ -0,0 +1,323 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// InsertionOrderedSet implements a collection of unique values, which
+// remembers the order the values were first added in, like a LinkedHashSet.
+//
+// It provides the same methods as [Set], so code can switch between both
+// easily. Iterating and [InsertionOrderedSet.Values] always produce the values
+// in insertion order, which makes output deterministic. Adding, removing and
+// looking up values take O(1).
+//
+// The order of the values follows these rules:
+//   - Adding a value appends it at the end, adding an already contained value
+//     keeps its position.
+//   - Removing a value and adding it again appends it at the end.
+//   - [InsertionOrderedSet.MoveToEnd] moves a contained value to the end.
+//   - Operations modifying the set keep the order of its remaining values and
+//     append new values in the order of the set they were taken from, see
+//     [InsertionOrderedSet.Union] and [InsertionOrderedSet.Unique].
+//
+// Like a [Set], an InsertionOrderedSet references its values, so copies share
+// them, and it is not thread-safe. Iterating while modifying the set is
+// allowed: Removed values are not produced anymore, added or moved values may
+// be produced (again) at the end.
+type InsertionOrderedSet[T comparable] struct {
+	list *insertionList[T]
+}
+
+// insertionList is a doubly linked list of the values in insertion order with
+// an index for finding the node of a value.
+type insertionList[T comparable] struct {
+	index map[T]*insertionNode[T]
+	head  *insertionNode[T]
+	tail  *insertionNode[T]
+}
+
+type insertionNode[T comparable] struct {
+	value      T
+	prev, next *insertionNode[T]
+	// removed marks nodes unlinked from the list, which keep their links, so
+	// iterators holding them can continue.
+	removed bool
+}
+
+// InsertionOrderedOf creates a new insertion ordered set with the given values
+// in the given order.
+func InsertionOrderedOf[T comparable](values ...T) InsertionOrderedSet[T] {
+	s := InsertionOrderedSet[T]{list: &insertionList[T]{index: make(map[T]*insertionNode[T], len(values))}}
+
+	s.Add(values...)
+
+	return s
+}
+
+// Add appends the given values to the set if they are not already present.
+func (s InsertionOrderedSet[T]) Add(values ...T) {
+	for _, v := range values {
+		if _, ok := s.list.index[v]; !ok {
+			s.list.append(v)
+		}
+	}
+}
+
+// Remove removes the given values from the set.
+func (s InsertionOrderedSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		if n, ok := s.list.index[v]; ok {
+			s.list.unlink(n)
+		}
+	}
+}
+
+// MoveToEnd moves v to the end of the set, as if it was removed and added
+// again. Returns false and does nothing, if v is not contained.
+func (s InsertionOrderedSet[T]) MoveToEnd(v T) bool {
+	n, ok := s.list.index[v]
+	if !ok {
+		return false
+	}
+
+	if n != s.list.tail {
+		s.list.unlink(n)
+		s.list.append(v)
+	}
+
+	return true
+}
+
+// PopFirst removes and returns the first value or returns an empty optional if
+// the set is empty.
+func (s InsertionOrderedSet[T]) PopFirst() optional.Optional[T] {
+	return s.list.pop(s.list.head)
+}
+
+// PopLast removes and returns the last value or returns an empty optional if
+// the set is empty.
+func (s InsertionOrderedSet[T]) PopLast() optional.Optional[T] {
+	return s.list.pop(s.list.tail)
+}
+
+// Clear removes all values from the set.
+func (s InsertionOrderedSet[T]) Clear() {
+	for n := s.list.head; n != nil; n = n.next {
+		n.removed = true
+	}
+
+	clear(s.list.index)
+	s.list.head, s.list.tail = nil, nil
+}
+
+// Values returns a slice of all values in the set in insertion order.
+func (s InsertionOrderedSet[T]) Values() []T {
+	values := make([]T, 0, len(s.list.index))
+
+	for n := s.list.head; n != nil; n = n.next {
+		values = append(values, n.value)
+	}
+
+	return values
+}
+
+// All creates an iterator over all values in the set in insertion order.
+func (s InsertionOrderedSet[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.list.head; n != nil; n = n.next {
+			if n.removed {
+				continue
+			}
+
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Backward creates an iterator over all values in the set in reverse
+// insertion order.
+func (s InsertionOrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.list.tail; n != nil; n = n.prev {
+			if n.removed {
+				continue
+			}
+
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Len returns the number of values in the set.
+func (s InsertionOrderedSet[T]) Len() int {
+	return len(s.list.index)
+}
+
+// IsEmpty returns true if the set is empty.
+func (s InsertionOrderedSet[T]) IsEmpty() bool {
+	return len(s.list.index) == 0
+}
+
+// Clone creates a shallow copy of the set with the same order.
+func (s InsertionOrderedSet[T]) Clone() InsertionOrderedSet[T] {
+	return InsertionOrderedOf(s.Values()...)
+}
+
+// ToSet creates a [Set] with the values of the set.
+func (s InsertionOrderedSet[T]) ToSet() Set[T] {
+	return Of(s.Values()...)
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(InsertionOrderedSet[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(InsertionOrderedSet[{{type}}]: <empty>)"
+//
+// The values are printed in insertion order.
+func (s InsertionOrderedSet[T]) String() string {
+	if s.IsEmpty() {
+		return fmt.Sprintf("(InsertionOrderedSet[%T]: <empty>)", *new(T))
+	}
+
+	return fmt.Sprintf("(InsertionOrderedSet[%T]: %v)", *new(T), s.Values())
+}
+
+// Contains checks if the set contains all the given values.
+func (s InsertionOrderedSet[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		if _, ok := s.list.index[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsExactly checks if the set contains all the given values and no more.
+func (s InsertionOrderedSet[T]) ContainsExactly(values ...T) bool {
+	if len(s.list.index) != len(values) {
+		return false
+	}
+
+	return s.Contains(values...)
+}
+
+// Union appends all values from the given sets, which are not already
+// contained, in the order of the given sets and their values.
+func (s InsertionOrderedSet[T]) Union(others ...InsertionOrderedSet[T]) {
+	for _, other := range others {
+		for v := range other.All() {
+			s.Add(v)
+		}
+	}
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets, keeping the order of the remaining values.
+func (s InsertionOrderedSet[T]) Intersection(others ...InsertionOrderedSet[T]) {
+	for n := s.list.head; n != nil; n = n.next {
+		for _, other := range others {
+			if !other.Contains(n.value) {
+				s.list.unlink(n)
+
+				break
+			}
+		}
+	}
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets, keeping the order of the remaining values.
+func (s InsertionOrderedSet[T]) Difference(others ...InsertionOrderedSet[T]) {
+	for _, other := range others {
+		for v := range other.All() {
+			s.Remove(v)
+		}
+	}
+}
+
+// Unique modifies the set, to only contain values which appear only in one set,
+// including the set itself and all given other sets.
+//
+// The remaining values of the set keep their order, values from the other sets
+// are appended in the order of the given sets and their values.
+func (s InsertionOrderedSet[T]) Unique(others ...InsertionOrderedSet[T]) {
+	if len(others) == 0 {
+		return
+	}
+
+	counts := make(map[T]int, len(s.list.index))
+
+	for v := range s.list.index {
+		counts[v]++
+	}
+
+	for _, other := range others {
+		for v := range other.list.index {
+			counts[v]++
+		}
+	}
+
+	for n := s.list.head; n != nil; n = n.next {
+		if counts[n.value] > 1 {
+			s.list.unlink(n)
+		}
+	}
+
+	for _, other := range others {
+		for v := range other.All() {
+			if counts[v] == 1 {
+				s.Add(v)
+			}
+		}
+	}
+}
+
+func (l *insertionList[T]) append(v T) {
+	n := &insertionNode[T]{value: v, prev: l.tail}
+
+	if l.tail == nil {
+		l.head = n
+	} else {
+		l.tail.next = n
+	}
+
+	l.tail = n
+	l.index[v] = n
+}
+
+// unlink removes n from the list. Its links are kept, so iterators can
+// continue from it.
+func (l *insertionList[T]) unlink(n *insertionNode[T]) {
+	if n.prev == nil {
+		l.head = n.next
+	} else {
+		n.prev.next = n.next
+	}
+
+	if n.next == nil {
+		l.tail = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+
+	n.removed = true
+	delete(l.index, n.value)
+}
+
+func (l *insertionList[T]) pop(n *insertionNode[T]) optional.Optional[T] {
+	if n == nil {
+		return optional.Empty[T]()
+	}
+
+	l.unlink(n)
+
+	return optional.Of(n.value)
+}
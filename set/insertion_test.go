@@ -0,0 +1,181 @@
+package set_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+var _ collection[int] = set.InsertionOrderedSet[int]{}
+
+func ExampleInsertionOrderedSet() {
+	s := set.InsertionOrderedOf("c", "a", "b", "a")
+
+	s.Add("d", "c")
+	s.MoveToEnd("a")
+
+	fmt.Println(s)
+	fmt.Println(slices.Collect(s.Backward()))
+	fmt.Println(s.PopFirst(), s.PopLast())
+	fmt.Println(s)
+
+	// Output:
+	// (InsertionOrderedSet[string]: [c b d a])
+	// [a d b c]
+	// (Optional[string]: c) (Optional[string]: a)
+	// (InsertionOrderedSet[string]: [b d])
+}
+
+func TestInsertionOrderedSet_order(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.InsertionOrderedOf(5, 3, 1)
+
+	// Act & Assert
+	s.Add(3, 4, 2)
+	assert.Equal(t, []int{5, 3, 1, 4, 2}, s.Values())
+
+	s.Remove(3)
+	s.Add(3)
+	assert.Equal(t, []int{5, 1, 4, 2, 3}, s.Values())
+
+	assert.True(t, s.MoveToEnd(5))
+	assert.True(t, s.MoveToEnd(5))
+	assert.False(t, s.MoveToEnd(42))
+	assert.Equal(t, []int{1, 4, 2, 3, 5}, slices.Collect(s.All()))
+	assert.Equal(t, []int{5, 3, 2, 4, 1}, slices.Collect(s.Backward()))
+
+	assert.True(t, s.ContainsExactly(1, 2, 3, 4, 5))
+	assert.True(t, s.ToSet().Equal(set.Of(1, 2, 3, 4, 5)))
+	assert.Equal(t, s.Values(), s.Clone().Values())
+}
+
+func TestInsertionOrderedSet_Pop(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.InsertionOrderedOf(1, 2, 3)
+
+	// Act & Assert
+	assert.Equal(t, optional.Of(1), s.PopFirst())
+	assert.Equal(t, optional.Of(3), s.PopLast())
+	assert.Equal(t, optional.Of(2), s.PopLast())
+	assert.Equal(t, optional.Empty[int](), s.PopFirst())
+	assert.Equal(t, optional.Empty[int](), s.PopLast())
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, "(InsertionOrderedSet[int]: <empty>)", s.String())
+
+	s.Add(4)
+	assert.Equal(t, []int{4}, s.Values())
+}
+
+func TestInsertionOrderedSet_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(s set.InsertionOrderedSet[int], others ...set.InsertionOrderedSet[int])
+		expect    []int
+	}
+
+	tests := []test{
+		{"union", set.InsertionOrderedSet[int].Union, []int{6, 1, 4, 3, 8, 2, 7, 5}},
+		{"intersection", set.InsertionOrderedSet[int].Intersection, []int{6, 4}},
+		{"difference", set.InsertionOrderedSet[int].Difference, []int{1, 3}},
+		{"unique", set.InsertionOrderedSet[int].Unique, []int{1, 3, 8, 2, 7, 5}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			s := set.InsertionOrderedOf(6, 1, 4, 3)
+			others := []set.InsertionOrderedSet[int]{
+				set.InsertionOrderedOf(8, 4, 6, 2),
+				set.InsertionOrderedOf(7, 5, 6, 4, 5),
+			}
+
+			// Act
+			tc.operation(s, others...)
+
+			// Assert
+			assert.Equal(t, tc.expect, s.Values())
+			assert.Equal(t, []int{8, 4, 6, 2}, others[0].Values(), "others must not be modified")
+		})
+	}
+}
+
+func TestInsertionOrderedSet_modifyWhileIterating(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remove current and following", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.InsertionOrderedOf(1, 2, 3, 4, 5, 6)
+
+		var visited []int
+
+		for v := range s.All() {
+			visited = append(visited, v)
+			s.Remove(v, v+1)
+		}
+
+		assert.Equal(t, []int{1, 3, 5}, visited)
+		assert.True(t, s.IsEmpty())
+	})
+
+	t.Run("remove backward", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.InsertionOrderedOf(1, 2, 3, 4, 5, 6)
+
+		var visited []int
+
+		for v := range s.Backward() {
+			visited = append(visited, v)
+			s.Remove(v, v-1)
+		}
+
+		assert.Equal(t, []int{6, 4, 2}, visited)
+	})
+
+	t.Run("move to end", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.InsertionOrderedOf(1, 2, 3)
+
+		var visited []int
+
+		for v := range s.All() {
+			visited = append(visited, v)
+
+			if v == 1 {
+				s.MoveToEnd(v)
+			}
+		}
+
+		assert.Equal(t, []int{1, 2, 3, 1}, visited)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.InsertionOrderedOf(1, 2, 3)
+
+		var visited []int
+
+		for v := range s.All() {
+			visited = append(visited, v)
+			s.Clear()
+		}
+
+		assert.Equal(t, []int{1}, visited)
+	})
+}
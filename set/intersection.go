@@ -43,6 +43,14 @@ func allContains[T comparable](others []Set[T], v T) bool {
 }
 
 func swapShortestFirst[T comparable](sets []Set[T]) {
+	indexShortest := indexOfShortest(sets)
+
+	if indexShortest != 0 {
+		sets[0], sets[indexShortest] = sets[indexShortest], sets[0]
+	}
+}
+
+func indexOfShortest[T comparable](sets []Set[T]) int {
 	indexShortest := 0
 	for i, set := range sets {
 		if len(set.keySetMap) < len(sets[indexShortest].keySetMap) {
@@ -50,7 +58,5 @@ func swapShortestFirst[T comparable](sets []Set[T]) {
 		}
 	}
 
-	if indexShortest != 0 {
-		sets[0], sets[indexShortest] = sets[indexShortest], sets[0]
-	}
+	return indexShortest
 }
@@ -0,0 +1,55 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/json"
+	"slices"
+)
+
+// Sorted wraps a [Set], so it is encoded with its values in ascending order,
+// e.g. for deterministic output in tests, diffs or signatures.
+//
+// Decoding works like for the embedded [Set].
+type Sorted[T cmp.Ordered] struct {
+	Set[T]
+}
+
+// MarshalJSON encodes the set as JSON array in no particular order.
+func (s Set[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.Values())
+}
+
+// UnmarshalJSON decodes a JSON array into the set, replacing its previous
+// values. Duplicates in the array are merged, like in [Of], and 'null' results
+// in an empty set.
+func (s *Set[T]) UnmarshalJSON(d []byte) error {
+	if string(bytes.TrimSpace(d)) == "null" {
+		*s = Of[T]()
+
+		return nil
+	}
+
+	var values []T
+
+	err := json.Unmarshal(d, &values)
+	if err != nil {
+		return err
+	}
+
+	*s = Of(values...)
+
+	return nil
+}
+
+// MarshalJSON encodes the set as JSON array in ascending order.
+func (s Sorted[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(s.sortedValues())
+}
+
+func (s Sorted[T]) sortedValues() []T {
+	values := s.Values()
+	slices.Sort(values)
+
+	return values
+}
@@ -0,0 +1,161 @@
+package set_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleSorted() {
+	type article struct {
+		Title string             `json:"title"`
+		Tags  set.Sorted[string] `json:"tags"`
+	}
+
+	a := article{Title: "Sets in Go", Tags: set.Sorted[string]{set.Of("go", "generics", "collections")}}
+
+	data, _ := json.Marshal(a)
+
+	fmt.Println(string(data))
+
+	// Output: {"title":"Sets in Go","tags":["collections","generics","go"]}
+}
+
+func TestSet_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		set    set.Set[int]
+		expect []int
+	}
+
+	tests := []test{
+		{"zero value", set.Set[int]{}, []int{}},
+		{"empty", set.Of[int](), []int{}},
+		{"values", set.Of(3, 1, 2), []int{1, 2, 3}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			data, err := json.Marshal(tc.set)
+
+			// Assert
+			require.NoError(t, err)
+
+			var values []int
+			require.NoError(t, json.Unmarshal(data, &values))
+			assert.ElementsMatch(t, tc.expect, values)
+			assert.NotNil(t, values)
+		})
+	}
+}
+
+func TestSorted_MarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	data, err := json.Marshal(set.Sorted[float64]{set.Of(2.5, -1, 10, 0)})
+	emptyData, emptyErr := json.Marshal(set.Sorted[float64]{})
+
+	// Assert
+	require.NoError(t, err)
+	require.NoError(t, emptyErr)
+	assert.JSONEq(t, `[-1, 0, 2.5, 10]`, string(data))
+	assert.Equal(t, "[-1,0,2.5,10]", string(data))
+	assert.Equal(t, "[]", string(emptyData))
+}
+
+func TestSet_UnmarshalJSON(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		data   string
+		expect []string
+	}
+
+	tests := []test{
+		{"empty", `[]`, []string{}},
+		{"null", `null`, []string{}},
+		{"values", `["a", "b"]`, []string{"a", "b"}},
+		{"duplicates are merged", `["a", "b", "a", "a"]`, []string{"a", "b"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			s := set.Of("previous")
+
+			// Act
+			err := json.Unmarshal([]byte(tc.data), &s)
+
+			// Assert
+			require.NoError(t, err)
+			assert.True(t, s.ContainsExactly(tc.expect...), s.String())
+		})
+	}
+}
+
+func TestSet_UnmarshalJSON_doesNotAffectCopies(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of(1, 2)
+	shared := s
+
+	// Act
+	err := json.Unmarshal([]byte(`[3]`), &s)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, s.ContainsExactly(3))
+	assert.True(t, shared.ContainsExactly(1, 2))
+}
+
+func TestSet_UnmarshalJSON_invalid(t *testing.T) {
+	t.Parallel()
+
+	for _, data := range []string{`{"a": 1}`, `["a", 1]`, `"a"`, `[`} {
+		var s set.Set[string]
+
+		err := json.Unmarshal([]byte(data), &s)
+
+		assert.Error(t, err, data)
+	}
+}
+
+func TestSet_JSON_inStruct(t *testing.T) {
+	t.Parallel()
+
+	type user struct {
+		Name  string             `json:"name"`
+		Roles set.Set[string]    `json:"roles"`
+		Tags  set.Sorted[string] `json:"tags"`
+	}
+
+	// Arrange
+	u := user{Name: "ada", Roles: set.Of("admin"), Tags: set.Sorted[string]{set.Of("z", "a")}}
+
+	// Act
+	data, err := json.Marshal(u)
+	require.NoError(t, err)
+
+	var decoded user
+	err = json.Unmarshal(data, &decoded)
+
+	// Assert
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"name":"ada","roles":["admin"],"tags":["a","z"]}`, string(data))
+	assert.Equal(t, u, decoded)
+}
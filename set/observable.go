@@ -0,0 +1,210 @@
+package set
+
+import (
+	"iter"
+	"slices"
+)
+
+// Observable wraps a [Set] and notifies subscribers about each change.
+//
+// The subscribers receive a [Patch] with only the values actually added or
+// removed by an operation, operations not changing the set are not reported.
+// Subscribers are called synchronously in the order they subscribed, after the
+// set was modified. Each subscriber receives its own copy of the patch, so
+// modifying it does not affect other subscribers.
+//
+// It is not thread-safe, including subscribing and unsubscribing.
+type Observable[T comparable] struct {
+	set         Set[T]
+	subscribers []*func(Patch[T])
+}
+
+// NewObservable creates an observable set with the given values.
+func NewObservable[T comparable](values ...T) *Observable[T] {
+	return &Observable[T]{set: Of(values...)}
+}
+
+// Subscribe registers fn to be called with each change of the set. The
+// returned function unsubscribes fn again.
+func (o *Observable[T]) Subscribe(fn func(Patch[T])) (unsubscribe func()) {
+	subscriber := &fn
+	o.subscribers = append(o.subscribers, subscriber)
+
+	return func() {
+		o.subscribers = slices.DeleteFunc(o.subscribers, func(s *func(Patch[T])) bool {
+			return s == subscriber
+		})
+	}
+}
+
+// SubscribeChan sends each change of the set to ch. The returned function
+// unsubscribes ch again.
+//
+// The change is sent synchronously by the modifying operation, so it blocks
+// until the change is received. Thus ch must be buffered or read by another
+// goroutine, and a slow or abandoned channel blocks all further modifications
+// of the set, until ch is unsubscribed.
+func (o *Observable[T]) SubscribeChan(ch chan<- Patch[T]) (unsubscribe func()) {
+	return o.Subscribe(func(p Patch[T]) { ch <- p })
+}
+
+// Add adds the given values to the set and returns true, if any value was not
+// already present.
+func (o *Observable[T]) Add(values ...T) bool {
+	added := Of[T]()
+
+	for _, v := range values {
+		if !o.set.Contains(v) {
+			o.set.Add(v)
+			added.Add(v)
+		}
+	}
+
+	return o.publish(Patch[T]{Added: added, Removed: Of[T]()})
+}
+
+// Remove removes the given values from the set and returns true, if any value
+// was present.
+func (o *Observable[T]) Remove(values ...T) bool {
+	removed := Of[T]()
+
+	for _, v := range values {
+		if o.set.Contains(v) {
+			o.set.Remove(v)
+			removed.Add(v)
+		}
+	}
+
+	return o.publish(Patch[T]{Added: Of[T](), Removed: removed})
+}
+
+// Clear removes all values from the set.
+func (o *Observable[T]) Clear() {
+	removed := o.set.Clone()
+
+	o.set.Clear()
+
+	o.publish(Patch[T]{Added: Of[T](), Removed: removed})
+}
+
+// Union adds all values from the given sets to the current set.
+func (o *Observable[T]) Union(others ...Set[T]) {
+	added := Of[T]()
+
+	for _, other := range others {
+		for v := range other.keySetMap {
+			if !o.set.Contains(v) {
+				o.set.Add(v)
+				added.Add(v)
+			}
+		}
+	}
+
+	o.publish(Patch[T]{Added: added, Removed: Of[T]()})
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets.
+func (o *Observable[T]) Intersection(others ...Set[T]) {
+	removed := Of[T]()
+
+	for v := range o.set.keySetMap {
+		if !allContains(others, v) {
+			o.set.Remove(v)
+			removed.Add(v)
+		}
+	}
+
+	o.publish(Patch[T]{Added: Of[T](), Removed: removed})
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets.
+func (o *Observable[T]) Difference(others ...Set[T]) {
+	removed := Of[T]()
+
+	for _, other := range others {
+		for v := range other.keySetMap {
+			if o.set.Contains(v) {
+				o.set.Remove(v)
+				removed.Add(v)
+			}
+		}
+	}
+
+	o.publish(Patch[T]{Added: Of[T](), Removed: removed})
+}
+
+// Apply applies the patch to the set like [Set.Apply], notifying the
+// subscribers with a single patch of the actual changes.
+func (o *Observable[T]) Apply(p Patch[T]) {
+	actual := Patch[T]{Added: Of[T](), Removed: Of[T]()}
+
+	for v := range p.Removed.keySetMap {
+		if o.set.Contains(v) && !p.Added.Contains(v) {
+			o.set.Remove(v)
+			actual.Removed.Add(v)
+		}
+	}
+
+	for v := range p.Added.keySetMap {
+		if !o.set.Contains(v) {
+			o.set.Add(v)
+			actual.Added.Add(v)
+		}
+	}
+
+	o.publish(actual)
+}
+
+// Contains checks if the set contains all the given values.
+func (o *Observable[T]) Contains(values ...T) bool {
+	return o.set.Contains(values...)
+}
+
+// Len returns the number of values in the set.
+func (o *Observable[T]) Len() int {
+	return o.set.Len()
+}
+
+// IsEmpty returns true if the set is empty.
+func (o *Observable[T]) IsEmpty() bool {
+	return o.set.IsEmpty()
+}
+
+// Values returns a slice of all values in the set without any particular
+// order.
+func (o *Observable[T]) Values() []T {
+	return o.set.Values()
+}
+
+// All creates an iterator over all values in the set without any particular
+// order.
+func (o *Observable[T]) All() iter.Seq[T] {
+	return o.set.All()
+}
+
+// Snapshot creates a [Set] with the current values, which is not observed.
+func (o *Observable[T]) Snapshot() Set[T] {
+	return o.set.Clone()
+}
+
+// String returns a string representation like [Set.String].
+func (o *Observable[T]) String() string {
+	return o.set.String()
+}
+
+// publish notifies all subscribers about p and returns true, if it is not
+// empty.
+func (o *Observable[T]) publish(p Patch[T]) bool {
+	if p.IsEmpty() {
+		return false
+	}
+
+	// Subscribers may unsubscribe while being notified.
+	for _, subscriber := range slices.Clone(o.subscribers) {
+		(*subscriber)(p.Clone())
+	}
+
+	return true
+}
@@ -0,0 +1,184 @@
+package set_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleObservable() {
+	s := set.NewObservable("a", "b")
+
+	s.Subscribe(func(p set.Patch[string]) {
+		fmt.Println("added:", p.Added, "removed:", p.Removed)
+	})
+
+	fmt.Println(s.Add("b", "c"))
+	fmt.Println(s.Add("c"))
+	s.Intersection(set.Of("a", "c"))
+
+	// Output:
+	// added: (Set[string]: [c]) removed: (Set[string]: <empty>)
+	// true
+	// false
+	// added: (Set[string]: <empty>) removed: (Set[string]: [b])
+}
+
+//nolint:funlen
+func TestObservable_changes(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name          string
+		change        func(o *set.Observable[int])
+		expectAdded   []int
+		expectRemoved []int
+	}
+
+	tests := []test{
+		{"add", func(o *set.Observable[int]) { o.Add(3, 4, 5) }, []int{4, 5}, nil},
+		{"add contained", func(o *set.Observable[int]) { o.Add(1, 2) }, nil, nil},
+		{"remove", func(o *set.Observable[int]) { o.Remove(3, 4) }, nil, []int{3}},
+		{"remove absent", func(o *set.Observable[int]) { o.Remove(7) }, nil, nil},
+		{"clear", func(o *set.Observable[int]) { o.Clear() }, nil, []int{1, 2, 3}},
+		{"union", func(o *set.Observable[int]) { o.Union(set.Of(2, 6), set.Of(6, 7)) }, []int{6, 7}, nil},
+		{"intersection", func(o *set.Observable[int]) { o.Intersection(set.Of(1, 2, 9), set.Of(2, 3)) }, nil, []int{1, 3}},
+		{"difference", func(o *set.Observable[int]) { o.Difference(set.Of(1, 9)) }, nil, []int{1}},
+		{
+			"apply",
+			func(o *set.Observable[int]) {
+				o.Apply(set.Patch[int]{Added: set.Of(3, 4), Removed: set.Of(1, 4, 9)})
+			},
+			[]int{4}, []int{1},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			o := set.NewObservable(1, 2, 3)
+			before := o.Snapshot()
+
+			var patches []set.Patch[int]
+
+			o.Subscribe(func(p set.Patch[int]) { patches = append(patches, p) })
+
+			// Act
+			tc.change(o)
+
+			// Assert
+			if len(tc.expectAdded)+len(tc.expectRemoved) == 0 {
+				assert.Empty(t, patches)
+
+				return
+			}
+
+			require.Len(t, patches, 1)
+			assert.True(t, patches[0].Added.ContainsExactly(tc.expectAdded...))
+			assert.True(t, patches[0].Removed.ContainsExactly(tc.expectRemoved...))
+
+			before.Apply(patches[0])
+			assert.True(t, before.Equal(o.Snapshot()), "patch must reproduce the change")
+		})
+	}
+}
+
+func TestObservable_Add_Remove_reportChanges(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := set.NewObservable[string]()
+
+	// Act & Assert
+	assert.True(t, o.Add("a"))
+	assert.False(t, o.Add("a"))
+	assert.True(t, o.Add("a", "b"))
+	assert.True(t, o.Remove("a", "c"))
+	assert.False(t, o.Remove("a", "c"))
+	assert.True(t, o.Contains("b"))
+	assert.Equal(t, 1, o.Len())
+	assert.Equal(t, []string{"b"}, o.Values())
+	assert.Equal(t, "(Set[string]: [b])", o.String())
+}
+
+func TestObservable_SubscribeChan(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := set.NewObservable[int]()
+	ch := make(chan set.Patch[int], 2)
+
+	unsubscribe := o.SubscribeChan(ch)
+
+	// Act
+	o.Add(1)
+	o.Remove(1)
+	unsubscribe()
+	o.Add(2)
+
+	// Assert
+	require.Len(t, ch, 2)
+	assert.True(t, (<-ch).Added.ContainsExactly(1))
+	assert.True(t, (<-ch).Removed.ContainsExactly(1))
+	assert.Empty(t, ch)
+}
+
+func TestObservable_unsubscribeWhileNotified(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := set.NewObservable[int]()
+
+	var calls []string
+
+	var unsubscribeFirst func()
+	unsubscribeFirst = o.Subscribe(func(set.Patch[int]) {
+		calls = append(calls, "first")
+		unsubscribeFirst()
+	})
+	o.Subscribe(func(set.Patch[int]) { calls = append(calls, "second") })
+
+	// Act
+	o.Add(1)
+	o.Add(2)
+
+	// Assert
+	assert.Equal(t, []string{"first", "second", "second"}, calls)
+	assert.False(t, o.IsEmpty())
+}
+
+func TestObservable_subscribersReceiveCopies(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	o := set.NewObservable[int]()
+	ch := make(chan set.Patch[int], 1)
+
+	o.Subscribe(func(p set.Patch[int]) {
+		p.Added.Clear()
+		p.Removed.Add(5)
+	})
+
+	var second set.Patch[int]
+
+	o.Subscribe(func(p set.Patch[int]) { second = p })
+	o.SubscribeChan(ch)
+
+	// Act
+	o.Add(1, 2)
+
+	// Assert
+	assert.True(t, second.Added.ContainsExactly(1, 2))
+	assert.True(t, second.Removed.IsEmpty())
+
+	received := <-ch
+	assert.True(t, received.Added.ContainsExactly(1, 2))
+	assert.True(t, received.Removed.IsEmpty())
+	assert.True(t, o.Contains(1, 2))
+}
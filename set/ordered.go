@@ -0,0 +1,246 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+)
+
+// OrderedSet implements a collection of unique values, which are kept sorted.
+//
+// It provides the same methods as [Set], so code can switch between both
+// easily, and additionally ordered queries like [OrderedSet.Floor],
+// [OrderedSet.Range] or [OrderedSet.Select]. Values are compared with
+// [cmp.Compare] ([OrderedOf]) or a custom comparison function
+// ([OrderedOfFunc]), values comparing as equal are considered the same value.
+//
+// It is backed by an indexable skip list, so adding, removing and looking up
+// values as well as [OrderedSet.Rank] and [OrderedSet.Select] take O(log n).
+//
+// Like a [Set], an OrderedSet references its values, so copies of an
+// OrderedSet share them, and it is not thread-safe. Iterating while modifying
+// the set is allowed: Removed values are not produced anymore, added values
+// may or may not be produced.
+type OrderedSet[T any] struct {
+	list *skipList[T]
+}
+
+// OrderedOf creates a new ordered set with the given values, sorted by
+// [cmp.Compare].
+func OrderedOf[T cmp.Ordered](values ...T) OrderedSet[T] {
+	return OrderedOfFunc(cmp.Compare[T], values...)
+}
+
+// OrderedOfFunc creates a new ordered set with the given values, sorted by
+// compare.
+//
+// compare must return a negative number if a < b, a positive number if a > b
+// and zero if both are equal, like [cmp.Compare].
+func OrderedOfFunc[T any](compare func(a, b T) int, values ...T) OrderedSet[T] {
+	s := OrderedSet[T]{list: newSkipList(compare)}
+
+	s.Add(values...)
+
+	return s
+}
+
+// Add adds the given values to the set if they are not already present.
+func (s OrderedSet[T]) Add(values ...T) {
+	for _, v := range values {
+		s.list.insert(v)
+	}
+}
+
+// Remove removes the given values from the set.
+func (s OrderedSet[T]) Remove(values ...T) {
+	for _, v := range values {
+		s.list.delete(v)
+	}
+}
+
+// Clear removes all values from the set.
+func (s OrderedSet[T]) Clear() {
+	s.list.clear()
+}
+
+// Values returns a slice of all values in the set in ascending order.
+func (s OrderedSet[T]) Values() []T {
+	values := make([]T, 0, s.list.length)
+
+	for v := range s.All() {
+		values = append(values, v)
+	}
+
+	return values
+}
+
+// All creates an iterator over all values in the set in ascending order.
+func (s OrderedSet[T]) All() iter.Seq[T] {
+	return s.ascendingFrom(s.list.first, nil)
+}
+
+// Backward creates an iterator over all values in the set in descending
+// order.
+func (s OrderedSet[T]) Backward() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := s.list.tail; n != nil; n = s.list.predecessor(n) {
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+// Range creates an iterator over all values v with from <= v < to in
+// ascending order.
+func (s OrderedSet[T]) Range(from, to T) iter.Seq[T] {
+	return s.ascendingFrom(func() *skipNode[T] { return s.list.ceiling(from) }, func(v T) bool {
+		return s.list.compare(v, to) < 0
+	})
+}
+
+// Len returns the number of values in the set.
+func (s OrderedSet[T]) Len() int {
+	return s.list.length
+}
+
+// IsEmpty returns true if the set is empty.
+func (s OrderedSet[T]) IsEmpty() bool {
+	return s.list.length == 0
+}
+
+// Clone creates a shallow copy of the set.
+func (s OrderedSet[T]) Clone() OrderedSet[T] {
+	return OrderedOfFunc(s.list.compare, s.Values()...)
+}
+
+// String returns a string representation in the format:
+//   - If Present: "(OrderedSet[{{type}}]: [{{value 1}} {{value 2}} ...])"
+//   - If Empty: "(OrderedSet[{{type}}]: <empty>)"
+//
+// The values are printed in ascending order.
+func (s OrderedSet[T]) String() string {
+	if s.IsEmpty() {
+		return fmt.Sprintf("(OrderedSet[%T]: <empty>)", *new(T))
+	}
+
+	return fmt.Sprintf("(OrderedSet[%T]: %v)", *new(T), s.Values())
+}
+
+// Contains checks if the set contains all the given values.
+func (s OrderedSet[T]) Contains(values ...T) bool {
+	for _, v := range values {
+		if s.list.find(v) == nil {
+			return false
+		}
+	}
+
+	return true
+}
+
+// ContainsExactly checks if the set contains all the given values and no more.
+func (s OrderedSet[T]) ContainsExactly(values ...T) bool {
+	if s.list.length != len(values) {
+		return false
+	}
+
+	return s.Contains(values...)
+}
+
+// Union adds all values from the given sets to the current set.
+func (s OrderedSet[T]) Union(others ...OrderedSet[T]) {
+	for _, other := range others {
+		for v := range other.All() {
+			s.list.insert(v)
+		}
+	}
+}
+
+// Intersection removes all values from the set that are not contained in all
+// other given sets.
+func (s OrderedSet[T]) Intersection(others ...OrderedSet[T]) {
+	for v := range s.All() {
+		for _, other := range others {
+			if !other.Contains(v) {
+				s.list.delete(v)
+
+				break
+			}
+		}
+	}
+}
+
+// Difference removes all values from the set that are contained in the other
+// sets.
+func (s OrderedSet[T]) Difference(others ...OrderedSet[T]) {
+	for _, other := range others {
+		for v := range other.All() {
+			s.list.delete(v)
+		}
+	}
+}
+
+// Min returns the smallest value or an empty optional if the set is empty.
+func (s OrderedSet[T]) Min() optional.Optional[T] {
+	return valueOf(s.list.first())
+}
+
+// Max returns the largest value or an empty optional if the set is empty.
+func (s OrderedSet[T]) Max() optional.Optional[T] {
+	return valueOf(s.list.tail)
+}
+
+// Floor returns the largest value less than or equal to v, or an empty
+// optional if there is none.
+func (s OrderedSet[T]) Floor(v T) optional.Optional[T] {
+	return valueOf(s.list.floor(v))
+}
+
+// Ceiling returns the smallest value greater than or equal to v, or an empty
+// optional if there is none.
+func (s OrderedSet[T]) Ceiling(v T) optional.Optional[T] {
+	return valueOf(s.list.ceiling(v))
+}
+
+// Rank returns the number of values in the set less than v, which is the index
+// of v, if it is contained.
+func (s OrderedSet[T]) Rank(v T) int {
+	return s.list.rank(v)
+}
+
+// Select returns the value at the given zero based index in ascending order.
+//
+// Panics if index is not within [0, Len()).
+func (s OrderedSet[T]) Select(index int) T {
+	if index < 0 || index >= s.list.length {
+		panic(fmt.Sprintf("index %d out of range [0, %d)", index, s.list.length))
+	}
+
+	return s.list.at(index).value
+}
+
+// ascendingFrom iterates from the node returned by start, while inRange
+// returns true for the values. A nil inRange iterates to the end.
+func (s OrderedSet[T]) ascendingFrom(start func() *skipNode[T], inRange func(T) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for n := start(); n != nil; n = s.list.successor(n) {
+			if inRange != nil && !inRange(n.value) {
+				return
+			}
+
+			if !yield(n.value) {
+				return
+			}
+		}
+	}
+}
+
+func valueOf[T any](n *skipNode[T]) optional.Optional[T] {
+	if n == nil {
+		return optional.Empty[T]()
+	}
+
+	return optional.Of(n.value)
+}
@@ -0,0 +1,300 @@
+package set_test
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/optional"
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+// collection is the method set shared by [set.Set] and [set.OrderedSet].
+type collection[T any] interface {
+	Add(values ...T)
+	Remove(values ...T)
+	Clear()
+	Values() []T
+	All() iter.Seq[T]
+	Len() int
+	IsEmpty() bool
+	Contains(values ...T) bool
+	ContainsExactly(values ...T) bool
+	String() string
+}
+
+var (
+	_ collection[int] = set.Set[int]{}
+	_ collection[int] = set.OrderedSet[int]{}
+)
+
+func ExampleOrderedSet() {
+	s := set.OrderedOf(42, 7, 19, 3, 25)
+
+	fmt.Println(s)
+	fmt.Println(s.Min(), s.Max())
+	fmt.Println(s.Floor(20), s.Ceiling(20))
+	fmt.Println(slices.Collect(s.Range(5, 25)))
+	fmt.Println(s.Rank(19), s.Select(2))
+
+	// Output:
+	// (OrderedSet[int]: [3 7 19 25 42])
+	// (Optional[int]: 3) (Optional[int]: 42)
+	// (Optional[int]: 19) (Optional[int]: 25)
+	// [7 19]
+	// 2 19
+}
+
+func ExampleOrderedOfFunc() {
+	byLength := func(a, b string) int {
+		return cmp.Or(cmp.Compare(len(a), len(b)), strings.Compare(a, b))
+	}
+
+	s := set.OrderedOfFunc(byLength, "banana", "fig", "apple", "kiwi")
+
+	fmt.Println(slices.Collect(s.Backward()))
+
+	// Output: [banana apple kiwi fig]
+}
+
+func TestOrderedSet_empty(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	s := set.OrderedOf[int]()
+
+	// Assert
+	assert.True(t, s.IsEmpty())
+	assert.Equal(t, "(OrderedSet[int]: <empty>)", s.String())
+	assert.Equal(t, optional.Empty[int](), s.Min())
+	assert.Equal(t, optional.Empty[int](), s.Max())
+	assert.Equal(t, optional.Empty[int](), s.Floor(1))
+	assert.Equal(t, optional.Empty[int](), s.Ceiling(1))
+	assert.Equal(t, 0, s.Rank(1))
+	assert.Empty(t, slices.Collect(s.All()))
+	assert.Empty(t, slices.Collect(s.Backward()))
+	assert.Empty(t, slices.Collect(s.Range(0, 10)))
+	assert.Panics(t, func() { s.Select(0) })
+}
+
+//nolint:funlen
+func TestOrderedSet_queries(t *testing.T) {
+	t.Parallel()
+
+	s := set.OrderedOf(10, 20, 30, 40)
+
+	type test struct {
+		name          string
+		v             int
+		expectFloor   optional.Optional[int]
+		expectCeiling optional.Optional[int]
+		expectRank    int
+	}
+
+	tests := []test{
+		{"below all", 5, optional.Empty[int](), optional.Of(10), 0},
+		{"lowest", 10, optional.Of(10), optional.Of(10), 0},
+		{"between", 25, optional.Of(20), optional.Of(30), 2},
+		{"contained", 30, optional.Of(30), optional.Of(30), 2},
+		{"highest", 40, optional.Of(40), optional.Of(40), 3},
+		{"above all", 50, optional.Of(40), optional.Empty[int](), 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.expectFloor, s.Floor(tc.v))
+			assert.Equal(t, tc.expectCeiling, s.Ceiling(tc.v))
+			assert.Equal(t, tc.expectRank, s.Rank(tc.v))
+		})
+	}
+
+	t.Run("range", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, []int{20, 30}, slices.Collect(s.Range(15, 40)))
+		assert.Equal(t, []int{10, 20}, slices.Collect(s.Range(10, 30)))
+		assert.Empty(t, slices.Collect(s.Range(30, 30)))
+		assert.Empty(t, slices.Collect(s.Range(40, 10)))
+	})
+
+	t.Run("select", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 10, s.Select(0))
+		assert.Equal(t, 40, s.Select(3))
+		assert.Panics(t, func() { s.Select(4) })
+		assert.Panics(t, func() { s.Select(-1) })
+	})
+}
+
+//nolint:funlen,gocognit
+func TestOrderedSet_randomOperations(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(1))
+
+	s := set.OrderedOf[int]()
+	reference := set.Of[int]()
+
+	for i := range 5000 {
+		// Act
+		v := rand.Intn(500)
+
+		if rand.Intn(3) == 0 {
+			s.Remove(v)
+			reference.Remove(v)
+		} else {
+			s.Add(v)
+			reference.Add(v)
+		}
+
+		if i%250 != 0 {
+			continue
+		}
+
+		// Assert
+		expect := reference.Values()
+		slices.Sort(expect)
+
+		require.Equal(t, expect, s.Values())
+		require.Equal(t, len(expect), s.Len())
+
+		backward := slices.Clone(expect)
+		slices.Reverse(backward)
+		require.True(t, slices.Equal(backward, slices.Collect(s.Backward())))
+
+		for index, v := range expect {
+			require.Equal(t, v, s.Select(index))
+			require.Equal(t, index, s.Rank(v))
+		}
+
+		probe := rand.Intn(520) - 10
+		rank, found := slices.BinarySearch(expect, probe)
+		require.Equal(t, rank, s.Rank(probe))
+		require.Equal(t, found, s.Contains(probe))
+
+		from, to := rand.Intn(500), rand.Intn(500)
+		expectRange := slices.DeleteFunc(slices.Clone(expect), func(v int) bool { return v < from || v >= to })
+		require.True(t, slices.Equal(expectRange, slices.Collect(s.Range(from, to))))
+	}
+}
+
+func TestOrderedSet_modifyWhileIterating(t *testing.T) {
+	t.Parallel()
+
+	t.Run("remove current and following", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.OrderedOf(1, 2, 3, 4, 5, 6)
+
+		var visited []int
+
+		for v := range s.All() {
+			visited = append(visited, v)
+			s.Remove(v, v+1)
+		}
+
+		assert.Equal(t, []int{1, 3, 5}, visited)
+		assert.True(t, s.IsEmpty())
+	})
+
+	t.Run("remove backward", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.OrderedOf(1, 2, 3, 4, 5, 6)
+
+		var visited []int
+
+		for v := range s.Backward() {
+			visited = append(visited, v)
+			s.Remove(v, v-1)
+		}
+
+		assert.Equal(t, []int{6, 4, 2}, visited)
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.OrderedOf(1, 2, 3)
+
+		var visited []int
+
+		for v := range s.All() {
+			visited = append(visited, v)
+			s.Clear()
+		}
+
+		assert.Equal(t, []int{1}, visited)
+	})
+}
+
+func TestOrderedSet_Operations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name      string
+		operation func(s set.OrderedSet[int], others ...set.OrderedSet[int])
+		reference func(s set.Set[int], others ...set.Set[int])
+	}
+
+	tests := []test{
+		{"union", set.OrderedSet[int].Union, set.Set[int].Union},
+		{"intersection", set.OrderedSet[int].Intersection, set.Set[int].Intersection},
+		{"difference", set.OrderedSet[int].Difference, set.Set[int].Difference},
+	}
+
+	values := [][]int{{1, 2, 3, 4, 5, 6}, {2, 4, 6, 8}, {4, 5, 6, 7}}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			ordered := make([]set.OrderedSet[int], len(values))
+			reference := make([]set.Set[int], len(values))
+
+			for i, v := range values {
+				ordered[i] = set.OrderedOf(v...)
+				reference[i] = set.Of(v...)
+			}
+
+			// Act
+			tc.operation(ordered[0], ordered[1:]...)
+			tc.reference(reference[0], reference[1:]...)
+
+			// Assert
+			expect := reference[0].Values()
+			slices.Sort(expect)
+
+			assert.Equal(t, expect, ordered[0].Values())
+		})
+	}
+}
+
+func TestOrderedSet_Clone(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.OrderedOfFunc(func(a, b int) int { return cmp.Compare(b, a) }, 1, 2, 3)
+
+	// Act
+	clone := s.Clone()
+	clone.Add(4)
+
+	// Assert
+	assert.Equal(t, []int{3, 2, 1}, s.Values())
+	assert.Equal(t, []int{4, 3, 2, 1}, clone.Values())
+	assert.True(t, clone.ContainsExactly(1, 2, 3, 4))
+}
@@ -0,0 +1,43 @@
+package set
+
+// Patch describes the changes between two versions of a [Set].
+type Patch[T comparable] struct {
+	// Added contains the values added to the old version.
+	Added Set[T]
+	// Removed contains the values removed from the old version.
+	Removed Set[T]
+}
+
+// Diff creates the [Patch] turning oldSet into newSet, containing the values
+// added to and removed from oldSet.
+func Diff[T comparable](oldSet, newSet Set[T]) Patch[T] {
+	return Patch[T]{
+		Added:   DifferenceOf(newSet, oldSet),
+		Removed: DifferenceOf(oldSet, newSet),
+	}
+}
+
+// IsEmpty returns true if the patch contains no changes.
+func (p Patch[T]) IsEmpty() bool {
+	return p.Added.IsEmpty() && p.Removed.IsEmpty()
+}
+
+// Invert creates the patch reverting p.
+func (p Patch[T]) Invert() Patch[T] {
+	return Patch[T]{Added: p.Removed, Removed: p.Added}
+}
+
+// Clone creates a deep copy of the patch.
+func (p Patch[T]) Clone() Patch[T] {
+	return Patch[T]{Added: p.Added.Clone(), Removed: p.Removed.Clone()}
+}
+
+// Apply applies the patch to the set, removing the removed values and adding
+// the added values.
+//
+// Applying the result of [Diff] to a set equal to the old version results in a
+// set equal to the new version.
+func (s Set[T]) Apply(p Patch[T]) {
+	s.Difference(p.Removed)
+	s.Union(p.Added)
+}
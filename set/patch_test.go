@@ -0,0 +1,86 @@
+package set_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleDiff() {
+	cached := set.Of("a", "b", "c")
+	current := set.Of("b", "c", "d")
+
+	patch := set.Diff(cached, current)
+	fmt.Println(patch.Added, patch.Removed)
+
+	cached.Apply(patch)
+	fmt.Println(cached.Equal(current))
+
+	// Output:
+	// (Set[string]: [d]) (Set[string]: [a])
+	// true
+}
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name          string
+		oldSet        []int
+		newSet        []int
+		expectAdded   []int
+		expectRemoved []int
+	}
+
+	tests := []test{
+		{"both empty", nil, nil, nil, nil},
+		{"equal", []int{1, 2}, []int{2, 1}, nil, nil},
+		{"only added", []int{1}, []int{1, 2, 3}, []int{2, 3}, nil},
+		{"only removed", []int{1, 2, 3}, []int{2}, nil, []int{1, 3}},
+		{"replaced", []int{1, 2}, []int{3, 4}, []int{3, 4}, []int{1, 2}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			oldSet, newSet := set.Of(tc.oldSet...), set.Of(tc.newSet...)
+
+			// Act
+			patch := set.Diff(oldSet, newSet)
+
+			applied := oldSet.Clone()
+			applied.Apply(patch)
+
+			reverted := newSet.Clone()
+			reverted.Apply(patch.Invert())
+
+			// Assert
+			assert.True(t, patch.Added.ContainsExactly(tc.expectAdded...))
+			assert.True(t, patch.Removed.ContainsExactly(tc.expectRemoved...))
+			assert.Equal(t, len(tc.expectAdded)+len(tc.expectRemoved) == 0, patch.IsEmpty())
+			assert.True(t, applied.Equal(newSet))
+			assert.True(t, reverted.Equal(oldSet))
+		})
+	}
+}
+
+func TestPatch_Clone(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	patch := set.Diff(set.Of(1, 2), set.Of(2, 3))
+
+	// Act
+	clone := patch.Clone()
+	clone.Added.Add(4)
+	clone.Removed.Clear()
+
+	// Assert
+	assert.True(t, patch.Added.ContainsExactly(3))
+	assert.True(t, patch.Removed.ContainsExactly(1))
+}
@@ -0,0 +1,182 @@
+package set
+
+import "iter"
+
+// IsSubsetOf checks if all values of the set are contained in other.
+func (s Set[T]) IsSubsetOf(other Set[T]) bool {
+	if len(s.keySetMap) > len(other.keySetMap) {
+		return false
+	}
+
+	return isContainedIn(s, other)
+}
+
+// IsProperSubsetOf checks if all values of the set are contained in other and
+// other contains at least one more value.
+func (s Set[T]) IsProperSubsetOf(other Set[T]) bool {
+	if len(s.keySetMap) >= len(other.keySetMap) {
+		return false
+	}
+
+	return isContainedIn(s, other)
+}
+
+// IsSupersetOf checks if the set contains all values of other.
+func (s Set[T]) IsSupersetOf(other Set[T]) bool {
+	return other.IsSubsetOf(s)
+}
+
+// IsDisjoint checks if the set and other have no values in common.
+func (s Set[T]) IsDisjoint(other Set[T]) bool {
+	smaller, larger := s, other
+	if len(smaller.keySetMap) > len(larger.keySetMap) {
+		smaller, larger = larger, smaller
+	}
+
+	for v := range smaller.keySetMap {
+		if _, ok := larger.keySetMap[v]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Equal checks if the set and other contain exactly the same values.
+func (s Set[T]) Equal(other Set[T]) bool {
+	if len(s.keySetMap) != len(other.keySetMap) {
+		return false
+	}
+
+	return isContainedIn(s, other)
+}
+
+// Compare compares the set and other by the partial order of inclusion:
+//   - -1, true if the set is a proper subset of other
+//   - 0, true if both sets are equal
+//   - +1, true if the set is a proper superset of other
+//   - 0, false if neither set contains the other
+func (s Set[T]) Compare(other Set[T]) (int, bool) {
+	switch l, otherLen := len(s.keySetMap), len(other.keySetMap); {
+	case l < otherLen && isContainedIn(s, other):
+		return -1, true
+	case l == otherLen && isContainedIn(s, other):
+		return 0, true
+	case l > otherLen && isContainedIn(other, s):
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// IsSubsetOfSeq checks if all values of the set are produced by seq.
+func (s Set[T]) IsSubsetOfSeq(seq iter.Seq[T]) bool {
+	r := s.relateSeq(seq, func(r seqRelation) bool { return r.common == len(s.keySetMap) })
+
+	return r.common == len(s.keySetMap)
+}
+
+// IsProperSubsetOfSeq checks if all values of the set are produced by seq and
+// seq produces at least one value not contained in the set.
+func (s Set[T]) IsProperSubsetOfSeq(seq iter.Seq[T]) bool {
+	r := s.relateSeq(seq, func(r seqRelation) bool { return r.common == len(s.keySetMap) && r.extra })
+
+	return r.common == len(s.keySetMap) && r.extra
+}
+
+// IsSupersetOfSeq checks if the set contains all values produced by seq.
+func (s Set[T]) IsSupersetOfSeq(seq iter.Seq[T]) bool {
+	for v := range seq {
+		if _, ok := s.keySetMap[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsDisjointSeq checks if seq produces no value contained in the set.
+func (s Set[T]) IsDisjointSeq(seq iter.Seq[T]) bool {
+	for v := range seq {
+		if _, ok := s.keySetMap[v]; ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+// EqualSeq checks if seq produces exactly the values of the set, ignoring
+// duplicates.
+func (s Set[T]) EqualSeq(seq iter.Seq[T]) bool {
+	r := s.relateSeq(seq, func(r seqRelation) bool { return r.extra })
+
+	return r.common == len(s.keySetMap) && !r.extra
+}
+
+// CompareSeq compares the set and the values produced by seq like
+// [Set.Compare].
+func (s Set[T]) CompareSeq(seq iter.Seq[T]) (int, bool) {
+	r := s.relateSeq(seq, func(r seqRelation) bool { return r.common == len(s.keySetMap) && r.extra })
+
+	switch isSubset := r.common == len(s.keySetMap); {
+	case isSubset && r.extra:
+		return -1, true
+	case isSubset:
+		return 0, true
+	case !r.extra:
+		return 1, true
+	default:
+		return 0, false
+	}
+}
+
+// seqRelation describes how the values of a sequence relate to a set.
+type seqRelation struct {
+	// common is the number of distinct values contained in the set.
+	common int
+	// extra is true if at least one value is not contained in the set.
+	extra bool
+}
+
+// relateSeq determines the relation of seq to the set, stopping as soon as
+// done returns true. done is only called after the relation changed.
+func (s Set[T]) relateSeq(seq iter.Seq[T], done func(seqRelation) bool) seqRelation {
+	var r seqRelation
+
+	seen := make(map[T]placeholderType)
+
+	for v := range seq {
+		if _, ok := s.keySetMap[v]; !ok {
+			if r.extra {
+				continue
+			}
+
+			r.extra = true
+		} else {
+			if _, ok := seen[v]; ok {
+				continue
+			}
+
+			seen[v] = placeholder
+			r.common++
+		}
+
+		if done(r) {
+			break
+		}
+	}
+
+	return r
+}
+
+// isContainedIn checks if all values of s are contained in other.
+func isContainedIn[T comparable](s, other Set[T]) bool {
+	for v := range s.keySetMap {
+		if _, ok := other.keySetMap[v]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,148 @@
+package set_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleSet_IsSubsetOf() {
+	required := set.Of("read", "write")
+	granted := set.Of("read", "write", "delete")
+
+	fmt.Println(required.IsSubsetOf(granted))
+	fmt.Println(required.IsProperSubsetOf(granted))
+	fmt.Println(granted.IsSubsetOf(required))
+
+	// Output:
+	// true
+	// true
+	// false
+}
+
+func ExampleSet_Compare() {
+	a := set.Of(1, 2)
+
+	fmt.Println(a.Compare(set.Of(1, 2, 3)))
+	fmt.Println(a.Compare(set.Of(2, 1)))
+	fmt.Println(a.Compare(set.Of(1)))
+	fmt.Println(a.Compare(set.Of(2, 3)))
+
+	// Output:
+	// -1 true
+	// 0 true
+	// 1 true
+	// 0 false
+}
+
+func ExampleSet_EqualSeq() {
+	s := set.Of("a", "b")
+
+	fmt.Println(s.EqualSeq(slices.Values([]string{"b", "a", "b"})))
+	fmt.Println(s.EqualSeq(slices.Values([]string{"a", "b", "c"})))
+
+	// Output:
+	// true
+	// false
+}
+
+//nolint:funlen
+func TestSet_relations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name           string
+		s              []int
+		other          []int
+		expectSubset   bool
+		expectProper   bool
+		expectSuperset bool
+		expectDisjoint bool
+		expectEqual    bool
+		expectCompare  int
+		expectOk       bool
+	}
+
+	tests := []test{
+		{"both empty", nil, nil, true, false, true, true, true, 0, true},
+		{"empty and non-empty", nil, []int{1}, true, true, false, true, false, -1, true},
+		{"non-empty and empty", []int{1}, nil, false, false, true, true, false, 1, true},
+		{"equal", []int{1, 2, 3}, []int{3, 2, 1}, true, false, true, false, true, 0, true},
+		{"proper subset", []int{1, 2}, []int{1, 2, 3}, true, true, false, false, false, -1, true},
+		{"proper subset, extra first", []int{1, 2}, []int{3, 1, 2}, true, true, false, false, false, -1, true},
+		{"overlapping, extra first", []int{1, 2}, []int{3, 1}, false, false, false, false, false, 0, false},
+		{"proper superset", []int{1, 2, 3}, []int{2, 3}, false, false, true, false, false, 1, true},
+		{"overlapping", []int{1, 2, 3}, []int{3, 4}, false, false, false, false, false, 0, false},
+		{"same length overlapping", []int{1, 2}, []int{2, 3}, false, false, false, false, false, 0, false},
+		{"disjoint", []int{1, 2}, []int{3, 4, 5}, false, false, false, true, false, 0, false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			s := set.Of(tc.s...)
+			other := set.Of(tc.other...)
+			// Duplicates must be ignored by the Seq variants.
+			seq := slices.Values(append(slices.Clone(tc.other), tc.other...))
+
+			// Act
+			compare, ok := s.Compare(other)
+			compareSeq, okSeq := s.CompareSeq(seq)
+
+			// Assert
+			assert.Equal(t, tc.expectSubset, s.IsSubsetOf(other), "IsSubsetOf")
+			assert.Equal(t, tc.expectProper, s.IsProperSubsetOf(other), "IsProperSubsetOf")
+			assert.Equal(t, tc.expectSuperset, s.IsSupersetOf(other), "IsSupersetOf")
+			assert.Equal(t, tc.expectDisjoint, s.IsDisjoint(other), "IsDisjoint")
+			assert.Equal(t, tc.expectEqual, s.Equal(other), "Equal")
+			assert.Equal(t, tc.expectCompare, compare, "Compare")
+			assert.Equal(t, tc.expectOk, ok, "Compare ok")
+
+			assert.Equal(t, tc.expectSubset, s.IsSubsetOfSeq(seq), "IsSubsetOfSeq")
+			assert.Equal(t, tc.expectProper, s.IsProperSubsetOfSeq(seq), "IsProperSubsetOfSeq")
+			assert.Equal(t, tc.expectSuperset, s.IsSupersetOfSeq(seq), "IsSupersetOfSeq")
+			assert.Equal(t, tc.expectDisjoint, s.IsDisjointSeq(seq), "IsDisjointSeq")
+			assert.Equal(t, tc.expectEqual, s.EqualSeq(seq), "EqualSeq")
+			assert.Equal(t, tc.expectCompare, compareSeq, "CompareSeq")
+			assert.Equal(t, tc.expectOk, okSeq, "CompareSeq ok")
+		})
+	}
+}
+
+func TestSet_relationsSeq_shouldStopEarly(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of(1, 2)
+
+	consumed := 0
+	seq := func(yield func(int) bool) {
+		for v := range 100 {
+			consumed++
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+
+	// Act & Assert
+	assert.False(t, s.IsSupersetOfSeq(seq))
+	assert.Equal(t, 1, consumed)
+
+	consumed = 0
+
+	assert.False(t, s.EqualSeq(seq))
+	assert.Equal(t, 1, consumed)
+
+	consumed = 0
+
+	assert.True(t, s.IsProperSubsetOfSeq(seq))
+	assert.Equal(t, 3, consumed)
+}
@@ -0,0 +1,131 @@
+package set
+
+import "iter"
+
+// UnionSeq creates an iterator over all values contained in any of the given
+// sets, without creating a new set like [UnionOf].
+//
+// The values of each set are produced, if they are not contained in any of the
+// sets before, so no value is produced twice.
+func UnionSeq[T comparable](sets ...Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, s := range sets {
+			for v := range s.keySetMap {
+				if anyContains(sets[:i], v) {
+					continue
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+// IntersectionSeq creates an iterator over the values contained in all given
+// sets, without creating a new set like [IntersectionOf].
+//
+// Only the values of the smallest set are probed against the other sets.
+func IntersectionSeq[T comparable](sets ...Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(sets) == 0 {
+			return
+		}
+
+		shortest := indexOfShortest(sets)
+
+		for v := range sets[shortest].keySetMap {
+			if !allContains(sets[:shortest], v) || !allContains(sets[shortest+1:], v) {
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// DifferenceSeq creates an iterator over the values of the first set, which
+// are not contained in any of the other sets, without creating a new set like
+// [DifferenceOf].
+func DifferenceSeq[T comparable](sets ...Set[T]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if len(sets) == 0 {
+			return
+		}
+
+		for v := range sets[0].keySetMap {
+			if anyContains(sets[1:], v) {
+				continue
+			}
+
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
+
+// SymmetricDifferenceSeq creates an iterator over the values contained in an
+// odd number of the given sets. For two sets, these are the values contained in
+// exactly one of them.
+//
+// Contrary to [UniqueSeq], the result for more than two sets is the same as
+// applying the symmetric difference pairwise, e.g. a value contained in all of
+// three sets is produced.
+func SymmetricDifferenceSeq[T comparable](sets ...Set[T]) iter.Seq[T] {
+	return countingSeq(sets, func(count int) bool { return count%2 == 1 })
+}
+
+// UniqueSeq creates an iterator over the values contained in exactly one of the
+// given sets, without creating a new set like [UniqueOf].
+func UniqueSeq[T comparable](sets ...Set[T]) iter.Seq[T] {
+	return countingSeq(sets, func(count int) bool { return count == 1 })
+}
+
+// countingSeq produces all values, for which include returns true for the
+// number of sets they are contained in.
+func countingSeq[T comparable](sets []Set[T], include func(count int) bool) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, s := range sets {
+			for v := range s.keySetMap {
+				// Each value is handled when seen the first time.
+				if anyContains(sets[:i], v) {
+					continue
+				}
+
+				if !include(1 + countContaining(sets[i+1:], v)) {
+					continue
+				}
+
+				if !yield(v) {
+					return
+				}
+			}
+		}
+	}
+}
+
+func anyContains[T comparable](sets []Set[T], v T) bool {
+	for _, s := range sets {
+		if s.Contains(v) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func countContaining[T comparable](sets []Set[T], v T) int {
+	count := 0
+
+	for _, s := range sets {
+		if s.Contains(v) {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,123 @@
+package set_test
+
+import (
+	"fmt"
+	"iter"
+	"math/rand"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleIntersectionSeq() {
+	admins := set.Of("ada", "bob")
+	active := set.Of("ada", "bob", "eve", "joe")
+	verified := set.Of("ada", "eve", "joe")
+
+	for user := range set.IntersectionSeq(admins, active, verified) {
+		fmt.Println(user)
+	}
+
+	// Output: ada
+}
+
+func ExampleSymmetricDifferenceSeq() {
+	a := set.Of(1, 2, 3)
+	b := set.Of(2, 3, 4)
+	c := set.Of(3, 4, 5)
+
+	symmetric := slices.Sorted(set.SymmetricDifferenceSeq(a, b, c))
+	unique := slices.Sorted(set.UniqueSeq(a, b, c))
+
+	fmt.Println(symmetric, unique)
+
+	// Output: [1 3 5] [1 5]
+}
+
+//nolint:funlen
+func TestSeqs_matchMaterializedOperations(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		seq    func(sets ...set.Set[int]) iter.Seq[int]
+		expect func(sets ...set.Set[int]) set.Set[int]
+	}
+
+	tests := []test{
+		{"union", set.UnionSeq[int], set.UnionOf[int]},
+		{"intersection", set.IntersectionSeq[int], set.IntersectionOf[int]},
+		{"difference", set.DifferenceSeq[int], set.DifferenceOf[int]},
+		{"unique", set.UniqueSeq[int], set.UniqueOf[int]},
+		{"symmetric difference", set.SymmetricDifferenceSeq[int], symmetricDifferenceOf},
+	}
+
+	//nolint:gosec
+	rand := rand.New(rand.NewSource(5))
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			for numSets := range 5 {
+				// Arrange
+				sets := make([]set.Set[int], numSets)
+				for i := range sets {
+					sets[i] = set.Of[int]()
+					for range rand.Intn(30) {
+						sets[i].Add(rand.Intn(40))
+					}
+				}
+
+				original := slices.Clone(sets)
+
+				// Act
+				values := slices.Collect(tc.seq(sets...))
+
+				// Assert
+				assert.Equal(t, original, sets, "sets must not be reordered")
+				assert.ElementsMatch(t, tc.expect(sets...).Values(), values)
+			}
+		})
+	}
+}
+
+// symmetricDifferenceOf applies the symmetric difference pairwise.
+func symmetricDifferenceOf(sets ...set.Set[int]) set.Set[int] {
+	result := set.Of[int]()
+
+	for _, s := range sets {
+		result = set.UniqueOf(result, s)
+	}
+
+	return result
+}
+
+func TestSeqs_shouldStopOnBreak(t *testing.T) {
+	t.Parallel()
+
+	a := set.Of(1, 2, 3, 4)
+	b := set.Of(3, 4, 5, 6)
+
+	for name, seq := range map[string]iter.Seq[int]{
+		"union":                set.UnionSeq(a, b),
+		"intersection":         set.IntersectionSeq(a, b),
+		"difference":           set.DifferenceSeq(a, b),
+		"unique":               set.UniqueSeq(a, b),
+		"symmetric difference": set.SymmetricDifferenceSeq(a, b),
+	} {
+		count := 0
+
+		for range seq {
+			count++
+			if count == 1 {
+				break
+			}
+		}
+
+		assert.Equal(t, 1, count, name)
+	}
+}
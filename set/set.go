@@ -5,7 +5,19 @@
 // clear as well as common set operations like union, intersection and
 // difference.
 //
-// The implementation is based on normal go map, thus is not thread-safe.
+// The implementation of [Set] is based on normal go map, thus is not
+// thread-safe. For concurrent use, [Concurrent] provides a lock-striped
+// thread-safe set. [Immutable] provides a persistent set, whose versions share
+// their unchanged parts. [BitSet] provides a compact set of non-negative
+// integers. [OrderedSet] and [InsertionOrderedSet] iterate their values in a
+// deterministic order. [HashSet] holds values of any type, hashed and
+// compared by a [Hasher].
+//
+// A [Set] can be encoded as JSON array, XML elements, gob and PostgreSQL array
+// literal for database/sql. The values are encoded in no particular order,
+// wrap the set in [Sorted] for a deterministic ascending order. When decoding,
+// duplicate values are merged into one, like in [Of], instead of being
+// rejected.
 package set
 
 import (
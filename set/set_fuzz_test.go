@@ -1,7 +1,9 @@
 package set_test
 
 import (
+	"cmp"
 	"math/rand"
+	"slices"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -72,7 +74,7 @@ func FuzzSet_Operations(f *testing.F) {
 	f.Add(int64(12348348193478192))
 	f.Add(int64(-32438914312))
 
-	f.Fuzz(func(_ *testing.T, seed int64) {
+	f.Fuzz(func(t *testing.T, seed int64) {
 		//nolint:gosec
 		rand := rand.New(rand.NewSource(seed))
 
@@ -91,9 +93,207 @@ func FuzzSet_Operations(f *testing.F) {
 			sets[i] = s
 		}
 
-		_ = set.UnionOf(sets...)
-		_ = set.IntersectionOf(sets...)
-		_ = set.DifferenceOf(sets...)
+		// IntersectionOf reorders the given sets, a clone keeps sets[0] first.
+		union := set.UnionOf(sets...)
+		intersection := set.IntersectionOf(slices.Clone(sets)...)
+		difference := set.DifferenceOf(sets...)
 		_ = set.UniqueOf(sets...)
+
+		checkIdentities(t, sets, union, intersection, difference)
+
+		for _, other := range append(sets, union, intersection, difference) {
+			checkRelations(t, sets[0], other)
+			checkRelations(t, other, sets[0])
+		}
+	})
+}
+
+// checkIdentities checks the relation predicates against the algebraic
+// identities of the sets A = sets[0] and B = sets[i] with their union,
+// intersection and the difference A\(B₁∪…∪Bₙ).
+func checkIdentities(t *testing.T, sets []set.Set[int], union, intersection, difference set.Set[int]) {
+	t.Helper()
+
+	a := sets[0]
+
+	assert.True(t, a.IsSubsetOf(a), "A ⊆ A")
+	assert.False(t, a.IsProperSubsetOf(a), "A ⊄ A")
+	assert.True(t, a.IsSubsetOf(union), "A ⊆ A∪B")
+	assert.True(t, union.IsSupersetOf(a), "A∪B ⊇ A")
+	assert.True(t, intersection.IsSubsetOf(a), "A∩B ⊆ A")
+	assert.True(t, intersection.IsSubsetOf(union), "A∩B ⊆ A∪B")
+	assert.True(t, difference.IsSubsetOf(a), "A\\B ⊆ A")
+	assert.True(t, a.IsSubsetOfSeq(union.All()), "A ⊆ A∪B (seq)")
+	assert.True(t, intersection.IsSubsetOfSeq(a.All()), "A∩B ⊆ A (seq)")
+
+	for i, b := range sets {
+		assert.Equal(t, a.IsSubsetOf(b) && b.IsSubsetOf(a), a.Equal(b), "A = B ⇔ A ⊆ B ∧ B ⊆ A")
+		assert.Equal(t, a.IsSubsetOf(b), b.IsSupersetOf(a), "A ⊆ B ⇔ B ⊇ A")
+		assert.Equal(t, a.IsSubsetOf(b) && !a.Equal(b), a.IsProperSubsetOf(b), "A ⊂ B ⇔ A ⊆ B ∧ A ≠ B")
+		assert.Equal(t, set.IntersectionOf(a, b).IsEmpty(), a.IsDisjoint(b), "A∩B = ∅ ⇔ A, B disjoint")
+		assert.True(t, b.IsSubsetOf(union), "B ⊆ A∪B")
+		assert.True(t, intersection.IsSubsetOf(b), "A∩B ⊆ B")
+
+		if i > 0 {
+			assert.True(t, difference.IsDisjoint(b), "A\\B ∩ B = ∅")
+		}
+
+		compare, ok := a.Compare(b)
+		assert.Equal(t, a.IsProperSubsetOf(b), ok && compare == -1, "Compare = -1 ⇔ A ⊂ B")
+		assert.Equal(t, b.IsProperSubsetOf(a), ok && compare == 1, "Compare = 1 ⇔ A ⊃ B")
+		assert.Equal(t, a.Equal(b), ok && compare == 0, "Compare = 0 ⇔ A = B")
+	}
+}
+
+// checkRelations checks the relation predicates of a and b against the
+// relation computed naively from their values. The Seq variants are checked
+// with the values of b, which are not contained in a, produced first.
+func checkRelations(t *testing.T, a, b set.Set[int]) {
+	t.Helper()
+
+	aValues, bValues := a.Values(), b.Values()
+
+	common := 0
+
+	for _, v := range aValues {
+		if slices.Contains(bValues, v) {
+			common++
+		}
+	}
+
+	isSubset := common == len(aValues)
+	isSuperset := common == len(bValues)
+
+	wantCompare, wantOk := 0, true
+
+	switch {
+	case isSubset && isSuperset:
+	case isSubset:
+		wantCompare = -1
+	case isSuperset:
+		wantCompare = 1
+	default:
+		wantOk = false
+	}
+
+	slices.SortFunc(bValues, func(x, y int) int {
+		return cmp.Compare(boolToInt(a.Contains(x)), boolToInt(a.Contains(y)))
+	})
+
+	seq := slices.Values(bValues)
+
+	assert.Equal(t, isSubset, a.IsSubsetOf(b), "IsSubsetOf")
+	assert.Equal(t, isSubset && !isSuperset, a.IsProperSubsetOf(b), "IsProperSubsetOf")
+	assert.Equal(t, isSuperset, a.IsSupersetOf(b), "IsSupersetOf")
+	assert.Equal(t, common == 0, a.IsDisjoint(b), "IsDisjoint")
+	assert.Equal(t, isSubset && isSuperset, a.Equal(b), "Equal")
+
+	assert.Equal(t, isSubset, a.IsSubsetOfSeq(seq), "IsSubsetOfSeq")
+	assert.Equal(t, isSubset && !isSuperset, a.IsProperSubsetOfSeq(seq), "IsProperSubsetOfSeq")
+	assert.Equal(t, isSuperset, a.IsSupersetOfSeq(seq), "IsSupersetOfSeq")
+	assert.Equal(t, common == 0, a.IsDisjointSeq(seq), "IsDisjointSeq")
+	assert.Equal(t, isSubset && isSuperset, a.EqualSeq(seq), "EqualSeq")
+
+	compare, ok := a.Compare(b)
+	assert.Equal(t, wantCompare, compare, "Compare")
+	assert.Equal(t, wantOk, ok, "Compare ok")
+
+	compare, ok = a.CompareSeq(seq)
+	assert.Equal(t, wantCompare, compare, "CompareSeq")
+	assert.Equal(t, wantOk, ok, "CompareSeq ok")
+}
+
+func boolToInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+func FuzzDisjointSet(f *testing.F) {
+	f.Add(int64(0))
+	f.Add(int64(32))
+	f.Add(int64(47))
+	f.Add(int64(-13))
+	f.Add(int64(2))
+	f.Add(int64(102))
+	f.Add(int64(12348348193478192))
+	f.Add(int64(-32438914312))
+
+	f.Fuzz(func(t *testing.T, seed int64) {
+		//nolint:gosec
+		rand := rand.New(rand.NewSource(seed))
+
+		numValues := rand.Intn(64) + 1
+
+		d := set.NewDisjointSet[int]()
+
+		// labels is a naive reference, assigning each value the label of its
+		// component and relabeling a whole component on union.
+		labels := make(map[int]int, numValues)
+
+		for range rand.Intn(128) {
+			a, b := rand.Intn(numValues), rand.Intn(numValues)
+
+			labelA, okA := labels[a]
+			if !okA {
+				labelA = a
+				labels[a] = a
+			}
+
+			labelB, okB := labels[b]
+			if !okB {
+				labelB = b
+				labels[b] = b
+			}
+
+			assert.Equal(t, labelA != labelB, d.Union(a, b))
+
+			for v, label := range labels {
+				if label == labelB {
+					labels[v] = labelA
+				}
+			}
+		}
+
+		checkComponents(t, d, labels)
 	})
 }
+
+// checkComponents checks the disjoint set against the labels of the values.
+func checkComponents(t *testing.T, d *set.DisjointSet[int], labels map[int]int) {
+	t.Helper()
+
+	expect := make(map[int]set.Set[int])
+
+	for v, label := range labels {
+		if _, ok := expect[label]; !ok {
+			expect[label] = set.Of[int]()
+		}
+
+		expect[label].Add(v)
+	}
+
+	assert.Equal(t, len(labels), d.Len())
+	assert.Equal(t, len(expect), d.ComponentCount())
+
+	for v, label := range labels {
+		assert.Equal(t, expect[label].Len(), d.Size(v))
+
+		representative, ok := d.Find(v).Get()
+		assert.True(t, ok)
+		assert.True(t, expect[label].Contains(representative))
+	}
+
+	for component := range d.Components() {
+		var first int
+		for v := range component.All() {
+			first = v
+
+			break
+		}
+
+		assert.True(t, component.Equal(expect[labels[first]]))
+	}
+}
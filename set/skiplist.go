@@ -0,0 +1,288 @@
+package set
+
+import (
+	"math/bits"
+	"math/rand/v2"
+)
+
+// maxSkipListLevel limits the levels of a skipList. With a probability of 1/4
+// per level, it is sufficient for far more values than fit into memory.
+const maxSkipListLevel = 32
+
+// skipList is an indexable skip list as described by William Pugh, where each
+// link additionally stores its span, the number of values it skips. This
+// allows finding values by their index and the index of values in O(log n).
+type skipList[T any] struct {
+	compare func(a, b T) int
+
+	head   skipNode[T]
+	tail   *skipNode[T]
+	level  int
+	length int
+}
+
+type skipNode[T any] struct {
+	value T
+	next  []skipLink[T]
+	prev  *skipNode[T]
+	// removed marks nodes deleted from the list, so iterators holding them
+	// can continue from the right position.
+	removed bool
+}
+
+type skipLink[T any] struct {
+	node *skipNode[T]
+	// span is the number of steps on the lowest level, this link skips.
+	span int
+}
+
+func newSkipList[T any](compare func(a, b T) int) *skipList[T] {
+	return &skipList[T]{
+		compare: compare,
+		head:    skipNode[T]{next: make([]skipLink[T], maxSkipListLevel)},
+		level:   1,
+	}
+}
+
+// first returns the node with the smallest value or nil if the list is empty.
+func (l *skipList[T]) first() *skipNode[T] {
+	return l.head.next[0].node
+}
+
+// ceiling returns the node with the smallest value >= v, or nil if there is
+// none.
+func (l *skipList[T]) ceiling(v T) *skipNode[T] {
+	return l.lastBefore(v).next[0].node
+}
+
+// successor returns the node following n, which is the node with the smallest
+// value greater than n's value, also if n was removed meanwhile.
+func (l *skipList[T]) successor(n *skipNode[T]) *skipNode[T] {
+	if !n.removed {
+		return n.next[0].node
+	}
+
+	next := l.ceiling(n.value)
+	if next != nil && l.compare(next.value, n.value) == 0 {
+		return next.next[0].node
+	}
+
+	return next
+}
+
+// predecessor returns the node preceding n, which is the node with the largest
+// value less than n's value, also if n was removed meanwhile.
+func (l *skipList[T]) predecessor(n *skipNode[T]) *skipNode[T] {
+	if !n.removed {
+		return n.prev
+	}
+
+	before := l.lastBefore(n.value)
+	if before == &l.head {
+		return nil
+	}
+
+	return before
+}
+
+// floor returns the node with the largest value <= v, or nil if there is none.
+func (l *skipList[T]) floor(v T) *skipNode[T] {
+	before := l.lastBefore(v)
+
+	if next := before.next[0].node; next != nil && l.compare(next.value, v) == 0 {
+		return next
+	}
+
+	if before == &l.head {
+		return nil
+	}
+
+	return before
+}
+
+// find returns the node with value v or nil if v is not in the list.
+func (l *skipList[T]) find(v T) *skipNode[T] {
+	next := l.ceiling(v)
+	if next == nil || l.compare(next.value, v) != 0 {
+		return nil
+	}
+
+	return next
+}
+
+// rank returns the number of values less than v.
+func (l *skipList[T]) rank(v T) int {
+	rank := 0
+	x := &l.head
+
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && l.compare(x.next[i].node.value, v) < 0 {
+			rank += x.next[i].span
+			x = x.next[i].node
+		}
+	}
+
+	return rank
+}
+
+// at returns the node at the zero based index i, which must be within
+// [0, length).
+func (l *skipList[T]) at(index int) *skipNode[T] {
+	traversed := 0
+	x := &l.head
+
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && traversed+x.next[i].span <= index+1 {
+			traversed += x.next[i].span
+			x = x.next[i].node
+		}
+
+		if traversed == index+1 {
+			return x
+		}
+	}
+
+	return nil
+}
+
+// lastBefore returns the last node with a value less than v, which is the head
+// if there is none.
+func (l *skipList[T]) lastBefore(v T) *skipNode[T] {
+	x := &l.head
+
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && l.compare(x.next[i].node.value, v) < 0 {
+			x = x.next[i].node
+		}
+	}
+
+	return x
+}
+
+// insert adds v to the list and reports whether it was absent before.
+func (l *skipList[T]) insert(v T) bool {
+	var (
+		update [maxSkipListLevel]*skipNode[T]
+		rank   [maxSkipListLevel]int
+	)
+
+	x := &l.head
+
+	for i := l.level - 1; i >= 0; i-- {
+		if i < l.level-1 {
+			rank[i] = rank[i+1]
+		}
+
+		for x.next[i].node != nil && l.compare(x.next[i].node.value, v) < 0 {
+			rank[i] += x.next[i].span
+			x = x.next[i].node
+		}
+
+		update[i] = x
+	}
+
+	if next := x.next[0].node; next != nil && l.compare(next.value, v) == 0 {
+		return false
+	}
+
+	level := randomSkipListLevel()
+	for i := l.level; i < level; i++ {
+		update[i] = &l.head
+		update[i].next[i].span = l.length
+	}
+
+	l.level = max(l.level, level)
+
+	node := &skipNode[T]{value: v, next: make([]skipLink[T], level)}
+
+	for i := range level {
+		node.next[i].node = update[i].next[i].node
+		update[i].next[i].node = node
+
+		node.next[i].span = update[i].next[i].span - (rank[0] - rank[i])
+		update[i].next[i].span = rank[0] - rank[i] + 1
+	}
+
+	for i := level; i < l.level; i++ {
+		update[i].next[i].span++
+	}
+
+	if update[0] != &l.head {
+		node.prev = update[0]
+	}
+
+	if node.next[0].node != nil {
+		node.next[0].node.prev = node
+	} else {
+		l.tail = node
+	}
+
+	l.length++
+
+	return true
+}
+
+// delete removes v from the list and reports whether it was present before.
+func (l *skipList[T]) delete(v T) bool {
+	var update [maxSkipListLevel]*skipNode[T]
+
+	x := &l.head
+
+	for i := l.level - 1; i >= 0; i-- {
+		for x.next[i].node != nil && l.compare(x.next[i].node.value, v) < 0 {
+			x = x.next[i].node
+		}
+
+		update[i] = x
+	}
+
+	node := x.next[0].node
+	if node == nil || l.compare(node.value, v) != 0 {
+		return false
+	}
+
+	for i := range l.level {
+		if update[i].next[i].node == node {
+			update[i].next[i].span += node.next[i].span - 1
+			update[i].next[i].node = node.next[i].node
+		} else {
+			update[i].next[i].span--
+		}
+	}
+
+	if node.next[0].node != nil {
+		node.next[0].node.prev = node.prev
+	} else {
+		l.tail = node.prev
+	}
+
+	for l.level > 1 && l.head.next[l.level-1].node == nil {
+		l.level--
+	}
+
+	node.removed = true
+	l.length--
+
+	return true
+}
+
+// clear removes all values from the list.
+func (l *skipList[T]) clear() {
+	for n := l.first(); n != nil; n = n.next[0].node {
+		n.removed = true
+	}
+
+	clear(l.head.next)
+	l.tail = nil
+	l.level = 1
+	l.length = 0
+}
+
+// randomSkipListLevel returns a random level, where each level is reached with
+// a probability of 1/4 of the one below.
+func randomSkipListLevel() int {
+	//nolint:gosec // No cryptographic randomness needed
+	level := 1 + bits.TrailingZeros64(rand.Uint64())/2
+
+	return min(level, maxSkipListLevel)
+}
@@ -0,0 +1,365 @@
+package set
+
+import (
+	"database/sql"
+	"database/sql/driver"
+	"encoding"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrInvalidArray is returned when scanning a value, which is no valid one
+// dimensional array literal.
+var ErrInvalidArray = errors.New("invalid array literal")
+
+// ErrUnsupportedType is returned when a value of the set can't be converted
+// from or to an element of an array literal.
+var ErrUnsupportedType = errors.New("unsupported type")
+
+// Value implements [driver.Valuer], encoding the set as array literal in the
+// text format of PostgreSQL, e.g. {1,2,3} or {"a b",c}, with values in no
+// particular order. Use [Sorted] for a deterministic order.
+//
+// Values implementing [driver.Valuer] are converted with it first, all other
+// values must be of a type supported by [driver.DefaultParameterConverter].
+func (s Set[T]) Value() (driver.Value, error) {
+	return arrayLiteral(s.Values())
+}
+
+// Scan implements [sql.Scanner], decoding an array literal in the text format
+// of PostgreSQL into the set, replacing its previous values. Duplicates in the
+// array are merged, like in [Of], and NULL results in an empty set.
+//
+// Elements are converted by [sql.Scanner] or [encoding.TextUnmarshaler] if
+// implemented by *T, otherwise T must be a string, bool or numeric type. NULL
+// elements are not supported.
+//
+// [time.Time] elements are parsed as RFC 3339 or in the output format of
+// PostgreSQL, e.g. "2024-01-02 03:04:05.123+00" for timestamptz. Timestamps
+// without time zone are taken as UTC, special values like infinity and BC
+// dates are not supported.
+func (s *Set[T]) Scan(src any) error {
+	var literal string
+
+	switch src := src.(type) {
+	case nil:
+		*s = Of[T]()
+
+		return nil
+	case string:
+		literal = src
+	case []byte:
+		literal = string(src)
+	default:
+		return fmt.Errorf("%w: can't scan %T into %T", ErrUnsupportedType, src, s)
+	}
+
+	elements, err := parseArrayLiteral(literal)
+	if err != nil {
+		return err
+	}
+
+	values := make([]T, len(elements))
+	for i, element := range elements {
+		err = scanElement(element, &values[i])
+		if err != nil {
+			return err
+		}
+	}
+
+	*s = Of(values...)
+
+	return nil
+}
+
+// Value implements [driver.Valuer] like [Set.Value], but with the values in
+// ascending order.
+func (s Sorted[T]) Value() (driver.Value, error) {
+	return arrayLiteral(s.sortedValues())
+}
+
+func arrayLiteral[T any](values []T) (string, error) {
+	var b strings.Builder
+
+	b.WriteByte('{')
+
+	for i, v := range values {
+		if i > 0 {
+			b.WriteByte(',')
+		}
+
+		element, err := formatElement(v)
+		if err != nil {
+			return "", err
+		}
+
+		b.WriteString(element)
+	}
+
+	b.WriteByte('}')
+
+	return b.String(), nil
+}
+
+func formatElement(v any) (string, error) {
+	if valuer, ok := v.(driver.Valuer); ok {
+		converted, err := valuer.Value()
+		if err != nil {
+			return "", err
+		}
+
+		v = converted
+	}
+
+	converted, err := driver.DefaultParameterConverter.ConvertValue(v)
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrUnsupportedType, err)
+	}
+
+	switch converted := converted.(type) {
+	case nil:
+		return "NULL", nil
+	case string:
+		return quoteElement(converted), nil
+	case []byte:
+		return quoteElement(`\x` + hex.EncodeToString(converted)), nil
+	case bool:
+		return strconv.FormatBool(converted), nil
+	case int64:
+		return strconv.FormatInt(converted, 10), nil
+	case float64:
+		return strconv.FormatFloat(converted, 'g', -1, 64), nil
+	case time.Time:
+		return quoteElement(converted.Format(time.RFC3339Nano)), nil
+	default:
+		return "", fmt.Errorf("%w: %T", ErrUnsupportedType, converted)
+	}
+}
+
+func quoteElement(s string) string {
+	if s != "" && !strings.EqualFold(s, "NULL") && !strings.ContainsAny(s, "{}\",\\ \t\n\r\v\f") {
+		return s
+	}
+
+	var b strings.Builder
+
+	b.WriteByte('"')
+
+	for _, r := range s {
+		if r == '"' || r == '\\' {
+			b.WriteByte('\\')
+		}
+
+		b.WriteRune(r)
+	}
+
+	b.WriteByte('"')
+
+	return b.String()
+}
+
+// arrayElement is an element of an array literal, which is NULL if null is set.
+type arrayElement struct {
+	value string
+	null  bool
+}
+
+func parseArrayLiteral(literal string) ([]arrayElement, error) {
+	literal = strings.TrimSpace(literal)
+
+	if len(literal) < 2 || literal[0] != '{' || literal[len(literal)-1] != '}' {
+		return nil, fmt.Errorf("%w: %q must be enclosed in braces", ErrInvalidArray, literal)
+	}
+
+	content := literal[1 : len(literal)-1]
+	if strings.TrimSpace(content) == "" {
+		return nil, nil
+	}
+
+	var elements []arrayElement
+
+	for {
+		element, rest, err := parseArrayElement(content)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q: %w", ErrInvalidArray, literal, err)
+		}
+
+		elements = append(elements, element)
+
+		if rest == "" {
+			return elements, nil
+		}
+
+		content = rest[1:]
+	}
+}
+
+// parseArrayElement parses the first element of content and returns it and the
+// remaining content, starting with the delimiting comma.
+func parseArrayElement(content string) (arrayElement, string, error) {
+	content = strings.TrimLeft(content, " \t\n\r\v\f")
+
+	if strings.HasPrefix(content, "{") {
+		return arrayElement{}, "", errors.New("multidimensional arrays are not supported")
+	}
+
+	if !strings.HasPrefix(content, `"`) {
+		return parseUnquotedElement(content)
+	}
+
+	var b strings.Builder
+
+	for i := 1; i < len(content); i++ {
+		switch content[i] {
+		case '\\':
+			i++
+			if i < len(content) {
+				b.WriteByte(content[i])
+			}
+		case '"':
+			rest := strings.TrimLeft(content[i+1:], " \t\n\r\v\f")
+			if rest != "" && rest[0] != ',' {
+				return arrayElement{}, "", errors.New("missing comma after quoted element")
+			}
+
+			return arrayElement{value: b.String()}, rest, nil
+		default:
+			b.WriteByte(content[i])
+		}
+	}
+
+	return arrayElement{}, "", errors.New("unterminated quoted element")
+}
+
+// parseUnquotedElement parses an unquoted element like parseArrayElement.
+// Backslashes escape the following character, which is then taken literally,
+// e.g. a delimiting comma or trailing whitespace.
+func parseUnquotedElement(content string) (arrayElement, string, error) {
+	var b strings.Builder
+
+	// keep is the length of the value without trailing unescaped whitespace.
+	keep, escaped := 0, false
+
+	i := 0
+	for ; i < len(content) && content[i] != ','; i++ {
+		switch c := content[i]; {
+		case c == '\\':
+			i++
+			if i == len(content) {
+				return arrayElement{}, "", errors.New("unterminated escape in unquoted element")
+			}
+
+			b.WriteByte(content[i])
+
+			keep, escaped = b.Len(), true
+		case strings.IndexByte(`{}"`, c) >= 0:
+			return arrayElement{}, "", fmt.Errorf("unexpected character %q in unquoted element", c)
+		default:
+			b.WriteByte(c)
+
+			if strings.IndexByte(" \t\n\r\v\f", c) < 0 {
+				keep = b.Len()
+			}
+		}
+	}
+
+	if keep == 0 {
+		return arrayElement{}, "", errors.New("empty unquoted element")
+	}
+
+	value := b.String()[:keep]
+
+	// Only the literal NULL is NULL, not an escaped one like N\ULL.
+	return arrayElement{value: value, null: !escaped && strings.EqualFold(value, "NULL")}, content[i:], nil
+}
+
+// postgresTimeLayouts are the layouts of timestamps and dates in the output
+// format of PostgreSQL, tried in order after RFC 3339.
+var postgresTimeLayouts = []string{
+	"2006-01-02 15:04:05Z07:00",
+	"2006-01-02 15:04:05-07",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+// scanTime parses value as RFC 3339 or in the output format of PostgreSQL.
+// Fractional seconds are accepted by all layouts.
+func scanTime(value string, target *time.Time) error {
+	t, err := time.Parse(time.RFC3339Nano, value)
+	if err == nil {
+		*target = t
+
+		return nil
+	}
+
+	for _, layout := range postgresTimeLayouts {
+		t, err = time.Parse(layout, value)
+		if err == nil {
+			*target = t
+
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%w: element %q is no supported timestamp", ErrInvalidArray, value)
+}
+
+func scanElement[T any](element arrayElement, target *T) error {
+	if element.null {
+		return fmt.Errorf("%w: NULL element can't be scanned into %T", ErrUnsupportedType, *target)
+	}
+
+	switch t := any(target).(type) {
+	case *time.Time:
+		return scanTime(element.value, t)
+	case sql.Scanner:
+		return t.Scan(element.value)
+	case encoding.TextUnmarshaler:
+		return t.UnmarshalText([]byte(element.value))
+	}
+
+	return scanBasicElement(element.value, reflect.ValueOf(target).Elem())
+}
+
+func scanBasicElement(value string, target reflect.Value) error {
+	var err error
+
+	//nolint:exhaustive // all other kinds are handled by default
+	switch target.Kind() {
+	case reflect.String:
+		target.SetString(value)
+	case reflect.Bool:
+		var b bool
+
+		b, err = strconv.ParseBool(value)
+		target.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		var i int64
+
+		i, err = strconv.ParseInt(value, 10, target.Type().Bits())
+		target.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		var u uint64
+
+		u, err = strconv.ParseUint(value, 10, target.Type().Bits())
+		target.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		var f float64
+
+		f, err = strconv.ParseFloat(value, target.Type().Bits())
+		target.SetFloat(f)
+	default:
+		return fmt.Errorf("%w: can't scan array element into %s", ErrUnsupportedType, target.Type())
+	}
+
+	if err != nil {
+		return fmt.Errorf("%w: element %q: %w", ErrInvalidArray, value, err)
+	}
+
+	return nil
+}
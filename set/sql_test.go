@@ -0,0 +1,259 @@
+package set_test
+
+import (
+	"database/sql/driver"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+// upper is a custom type, implementing driver.Valuer and sql.Scanner.
+type upper string
+
+func (u upper) Value() (driver.Value, error) {
+	return strings.ToLower(string(u)), nil
+}
+
+func (u *upper) Scan(src any) error {
+	*u = upper(strings.ToUpper(src.(string)))
+
+	return nil
+}
+
+func ExampleSorted_Value() {
+	s := set.Sorted[string]{set.Of("go", "sql", "hello world", `"quoted"`)}
+
+	value, _ := s.Value()
+
+	fmt.Println(value)
+
+	// Output: {"\"quoted\"",go,"hello world",sql}
+}
+
+//nolint:funlen
+func TestSet_Value(t *testing.T) {
+	t.Parallel()
+
+	date := time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)
+
+	type test struct {
+		name   string
+		value  driver.Valuer
+		expect string
+	}
+
+	tests := []test{
+		{"zero value", set.Set[int]{}, "{}"},
+		{"ints", set.Sorted[int]{set.Of(3, -1, 2)}, "{-1,2,3}"},
+		{"floats", set.Sorted[float64]{set.Of(0.5, 1e21)}, "{0.5,1e+21}"},
+		{"bools", set.Of(true), "{true}"},
+		{"empty string", set.Of(""), `{""}`},
+		{"string NULL", set.Of("null"), `{"null"}`},
+		{"special characters", set.Of(`a{b}`), `{"a{b}"}`},
+		{"backslash", set.Of(`a\b`), `{"a\\b"}`},
+		{"comma", set.Of("a,b"), `{"a,b"}`},
+		{"time", set.Of(date), `{2025-03-01T12:30:00Z}`},
+		{"valuer", set.Of[upper]("ABC"), "{abc}"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Act
+			value, err := tc.value.Value()
+
+			// Assert
+			require.NoError(t, err)
+			assert.Equal(t, tc.expect, value)
+		})
+	}
+}
+
+func TestSet_Value_unsupported(t *testing.T) {
+	t.Parallel()
+
+	_, err := set.Of(point{1, 2}).Value()
+
+	assert.ErrorIs(t, err, set.ErrUnsupportedType)
+}
+
+//nolint:funlen
+func TestSet_Scan(t *testing.T) {
+	t.Parallel()
+
+	t.Run("strings", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[string]
+
+		err := s.Scan(`{a, "b c" ,"d\"e","f\\g",NULLABLE,"NULL",""}`)
+
+		require.NoError(t, err)
+		assert.True(t, s.ContainsExactly("a", "b c", `d"e`, `f\g`, "NULLABLE", "NULL", ""), s.String())
+	})
+
+	t.Run("escaped unquoted", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[string]
+
+		err := s.Scan(`{a\,b, c\\d ,\ e\ ,N\ULL,x\"y}`)
+
+		require.NoError(t, err)
+		assert.True(t, s.ContainsExactly("a,b", `c\d`, " e ", "NULL", `x"y`), s.String())
+	})
+
+	t.Run("bytes and duplicates", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[int16]
+
+		err := s.Scan([]byte(`{1,2,2,-3,1}`))
+
+		require.NoError(t, err)
+		assert.True(t, s.ContainsExactly(1, 2, -3))
+	})
+
+	t.Run("bools", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[bool]
+
+		require.NoError(t, s.Scan(`{t,f,true}`))
+		assert.True(t, s.ContainsExactly(true, false))
+	})
+
+	t.Run("floats and uints", func(t *testing.T) {
+		t.Parallel()
+
+		var floats set.Set[float32]
+
+		var uints set.Set[uint8]
+
+		require.NoError(t, floats.Scan(`{1.5,-2e3}`))
+		require.NoError(t, uints.Scan(`{255}`))
+		assert.True(t, floats.ContainsExactly(1.5, -2000))
+		assert.True(t, uints.ContainsExactly(255))
+	})
+
+	t.Run("text unmarshaler", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[time.Time]
+
+		require.NoError(t, s.Scan(`{"2025-03-01T12:30:00Z"}`))
+		assert.True(t, s.ContainsExactly(time.Date(2025, 3, 1, 12, 30, 0, 0, time.UTC)))
+	})
+
+	t.Run("postgres timestamps", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[time.Time]
+
+		err := s.Scan(`{"2024-01-02 03:04:05+00","2024-01-02 03:04:05.5+05:30","2024-01-02 04:00:00",2024-01-03}`)
+
+		require.NoError(t, err)
+
+		want := []time.Time{
+			time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC),
+			time.Date(2024, 1, 2, 3, 4, 5, 5e8, time.FixedZone("", 5*60*60+30*60)),
+			time.Date(2024, 1, 2, 4, 0, 0, 0, time.UTC),
+			time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		}
+
+		got := s.Values()
+		require.Len(t, got, len(want), s.String())
+
+		for _, w := range want {
+			assert.True(t, slices.ContainsFunc(got, w.Equal), "missing %v in %v", w, got)
+		}
+	})
+
+	t.Run("scanner", func(t *testing.T) {
+		t.Parallel()
+
+		var s set.Set[upper]
+
+		require.NoError(t, s.Scan(`{abc}`))
+		assert.True(t, s.ContainsExactly("ABC"))
+	})
+
+	t.Run("NULL and empty", func(t *testing.T) {
+		t.Parallel()
+
+		s := set.Of(1)
+		require.NoError(t, s.Scan(nil))
+		assert.True(t, s.IsEmpty())
+
+		s = set.Of(1)
+		require.NoError(t, s.Scan(" { } "))
+		assert.True(t, s.IsEmpty())
+	})
+}
+
+func TestSet_Scan_invalid(t *testing.T) {
+	t.Parallel()
+
+	type test struct {
+		name   string
+		src    any
+		expect error
+	}
+
+	tests := []test{
+		{"unsupported source", 42, set.ErrUnsupportedType},
+		{"no braces", "1,2", set.ErrInvalidArray},
+		{"missing closing brace", "{1,2", set.ErrInvalidArray},
+		{"multidimensional", "{{1,2},{3,4}}", set.ErrInvalidArray},
+		{"empty element", "{1,,2}", set.ErrInvalidArray},
+		{"trailing comma", "{1,}", set.ErrInvalidArray},
+		{"unterminated quote", `{"1}`, set.ErrInvalidArray},
+		{"garbage after quote", `{"1"2}`, set.ErrInvalidArray},
+		{"not a number", "{1,x}", set.ErrInvalidArray},
+		{"out of range", "{128}", set.ErrInvalidArray},
+		{"NULL element", "{1,NULL}", set.ErrUnsupportedType},
+		{"unterminated escape", `{1\}`, set.ErrInvalidArray},
+		{"escaped comma in number", `{1\,2}`, set.ErrInvalidArray},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			// Arrange
+			var s set.Set[int8]
+
+			// Act
+			err := s.Scan(tc.src)
+
+			// Assert
+			assert.ErrorIs(t, err, tc.expect)
+		})
+	}
+}
+
+func TestSet_SQL_roundTrip(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of("", "plain", "with space", `"quoted"`, `back\slash`, "{braces}", "NULL", "ünïcödé")
+
+	// Act
+	value, err := s.Value()
+	require.NoError(t, err)
+
+	var decoded set.Set[string]
+	err = decoded.Scan(value)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t, s, decoded)
+}
@@ -0,0 +1,43 @@
+package set
+
+// Filter creates a new set with the values of s, for which keep returns true.
+func Filter[T comparable](s Set[T], keep func(T) bool) Set[T] {
+	filtered := Of[T]()
+
+	for v := range s.keySetMap {
+		if keep(v) {
+			filtered.Add(v)
+		}
+	}
+
+	return filtered
+}
+
+// Map creates a new set with the results of fn for all values of s. As
+// different values may be mapped to the same result, the new set may be
+// smaller than s.
+func Map[T, U comparable](s Set[T], fn func(T) U) Set[U] {
+	mapped := WithCapacity[U](len(s.keySetMap))
+
+	for v := range s.keySetMap {
+		mapped.Add(fn(v))
+	}
+
+	return mapped
+}
+
+// Partition splits the values of s into two new sets, the values for which
+// predicate returns true and the ones for which it returns false.
+func Partition[T comparable](s Set[T], predicate func(T) bool) (matching, rest Set[T]) {
+	matching, rest = Of[T](), Of[T]()
+
+	for v := range s.keySetMap {
+		if predicate(v) {
+			matching.Add(v)
+		} else {
+			rest.Add(v)
+		}
+	}
+
+	return matching, rest
+}
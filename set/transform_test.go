@@ -0,0 +1,78 @@
+package set_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+func ExampleMap() {
+	emails := set.Of("ada@example.com", "bob@example.org", "eve@example.com")
+
+	domains := set.Map(emails, func(email string) string {
+		_, domain, _ := strings.Cut(email, "@")
+
+		return domain
+	})
+
+	fmt.Println(domains)
+
+	// Output: (Set[string]: [example.com example.org])
+}
+
+func ExamplePartition() {
+	even, odd := set.Partition(set.Of(1, 2, 3, 4, 5), func(v int) bool { return v%2 == 0 })
+
+	fmt.Println(even, odd)
+
+	// Output: (Set[int]: [2 4]) (Set[int]: [1 3 5])
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of(1, 2, 3, 4, 5, 6)
+
+	// Act
+	filtered := set.Filter(s, func(v int) bool { return v > 3 })
+	none := set.Filter(s, func(int) bool { return false })
+
+	// Assert
+	assert.True(t, filtered.ContainsExactly(4, 5, 6))
+	assert.True(t, none.IsEmpty())
+	assert.Equal(t, 6, s.Len(), "source must not be modified")
+}
+
+func TestMap(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	mapped := set.Map(set.Of(point{1, 2}, point{1, 3}, point{2, 2}), func(p point) int { return p.X })
+	empty := set.Map(set.Of[int](), func(v int) string { return fmt.Sprint(v) })
+
+	// Assert
+	assert.True(t, mapped.ContainsExactly(1, 2))
+	assert.True(t, empty.IsEmpty())
+}
+
+func TestPartition(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	s := set.Of("a", "bb", "ccc", "dd")
+
+	// Act
+	long, short := set.Partition(s, func(v string) bool { return len(v) > 1 })
+	all, none := set.Partition(s, func(string) bool { return true })
+
+	// Assert
+	assert.True(t, long.ContainsExactly("bb", "ccc", "dd"))
+	assert.True(t, short.ContainsExactly("a"))
+	assert.True(t, all.ContainsExactly("a", "bb", "ccc", "dd"))
+	assert.True(t, none.IsEmpty())
+}
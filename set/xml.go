@@ -0,0 +1,47 @@
+package set
+
+import "encoding/xml"
+
+// MarshalXML encodes each value of the set as its own element, in no
+// particular order, like encoding/xml does for slices. An empty set creates no
+// element.
+func (s Set[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLValues(e, start, s.Values())
+}
+
+// UnmarshalXML decodes an element into a value and adds it to the set, so
+// repeated elements are collected like encoding/xml does for slices. Duplicate
+// values are merged, like in [Of].
+func (s *Set[T]) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var value T
+
+	err := d.DecodeElement(&value, &start)
+	if err != nil {
+		return err
+	}
+
+	if s.keySetMap == nil {
+		*s = Of[T]()
+	}
+
+	s.Add(value)
+
+	return nil
+}
+
+// MarshalXML encodes each value of the set as its own element in ascending
+// order.
+func (s Sorted[T]) MarshalXML(e *xml.Encoder, start xml.StartElement) error {
+	return marshalXMLValues(e, start, s.sortedValues())
+}
+
+func marshalXMLValues[T any](e *xml.Encoder, start xml.StartElement, values []T) error {
+	for _, v := range values {
+		err := e.EncodeElement(v, start)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
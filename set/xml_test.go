@@ -0,0 +1,72 @@
+package set_test
+
+import (
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/KrischanCS/go-toolbox/set"
+)
+
+type xmlTestType struct {
+	XMLName xml.Name           `xml:"Config"`
+	Hosts   set.Sorted[string] `xml:"Host"`
+	Ports   set.Set[int]       `xml:"Port"`
+	Empty   set.Set[int]       `xml:"Empty"`
+}
+
+func TestSet_MarshalXML(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	config := xmlTestType{
+		Hosts: set.Sorted[string]{set.Of("b.example.com", "a.example.com")},
+		Ports: set.Of(8080),
+		Empty: set.Of[int](),
+	}
+
+	// Act
+	data, err := xml.Marshal(config)
+
+	// Assert
+	require.NoError(t, err)
+	assert.Equal(t,
+		"<Config><Host>a.example.com</Host><Host>b.example.com</Host><Port>8080</Port></Config>",
+		string(data))
+}
+
+func TestSet_UnmarshalXML(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	data := `<Config>
+	<Host>a.example.com</Host>
+	<Port>80</Port>
+	<Host>b.example.com</Host>
+	<Port>443</Port>
+	<Port>80</Port>
+</Config>`
+
+	var config xmlTestType
+
+	// Act
+	err := xml.Unmarshal([]byte(data), &config)
+
+	// Assert
+	require.NoError(t, err)
+	assert.True(t, config.Hosts.ContainsExactly("a.example.com", "b.example.com"))
+	assert.True(t, config.Ports.ContainsExactly(80, 443))
+	assert.True(t, config.Empty.IsEmpty())
+}
+
+func TestSet_UnmarshalXML_invalid(t *testing.T) {
+	t.Parallel()
+
+	var config xmlTestType
+
+	err := xml.Unmarshal([]byte(`<Config><Port>http</Port></Config>`), &config)
+
+	assert.Error(t, err)
+}